@@ -0,0 +1,366 @@
+// Command cdpgen generates the typed Chrome DevTools Protocol model in
+// internal/protocol from the protocol schema files Chrome publishes
+// (browser_protocol.json and js_protocol.json).
+//
+// Usage:
+//
+//	go run ./cmd/cdpgen -out internal/protocol schema.json...
+//
+// Every domain of the given schemas is generated unless -domains limits
+// them. References to types of domains that are not generated decode as
+// raw JSON.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// schema is a protocol description file
+type schema struct {
+	Version struct {
+		Major string `json:"major"`
+		Minor string `json:"minor"`
+	} `json:"version"`
+	Domains []*domain `json:"domains"`
+}
+
+type domain struct {
+	Domain      string      `json:"domain"`
+	Description string      `json:"description"`
+	Types       []*typeDesc `json:"types"`
+	Commands    []*method   `json:"commands"`
+	Events      []*method   `json:"events"`
+}
+
+// typeDesc describes a named type, a property or a parameter
+type typeDesc struct {
+	ID          string      `json:"id"`
+	Name        string      `json:"name"`
+	Description string      `json:"description"`
+	Type        string      `json:"type"`
+	Ref         string      `json:"$ref"`
+	Items       *typeDesc   `json:"items"`
+	Properties  []*typeDesc `json:"properties"`
+	Optional    bool        `json:"optional"`
+}
+
+type method struct {
+	Name        string      `json:"name"`
+	Description string      `json:"description"`
+	Parameters  []*typeDesc `json:"parameters"`
+	Returns     []*typeDesc `json:"returns"`
+}
+
+// initialisms are spelled in capitals in Go names
+var initialisms = map[string]string{
+	"Api":  "API",
+	"Css":  "CSS",
+	"Dom":  "DOM",
+	"Html": "HTML",
+	"Http": "HTTP",
+	"Id":   "ID",
+	"Ip":   "IP",
+	"Js":   "JS",
+	"Json": "JSON",
+	"Uri":  "URI",
+	"Url":  "URL",
+}
+
+func main() {
+	out := flag.String("out", ".", "directory the generated files are written to")
+	only := flag.String("domains", "", "comma separated domains to generate (default all)")
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		log.Fatalf("usage: cdpgen [-out dir] [-domains a,b] schema.json...")
+	}
+
+	wanted := make(map[string]bool)
+	for _, name := range strings.Split(*only, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			wanted[name] = true
+		}
+	}
+
+	var domains []*domain
+	for _, path := range flag.Args() {
+		s, err := readSchema(path)
+		if err != nil {
+			log.Fatalf("Error reading schema: %v", err)
+		}
+		for _, d := range s.Domains {
+			if len(wanted) == 0 || wanted[d.Domain] {
+				domains = append(domains, d)
+			}
+		}
+	}
+
+	g := newGenerator(domains)
+	for _, d := range domains {
+		if err := g.writeFile(filepath.Join(*out, strings.ToLower(d.Domain)+".go"), g.domainFile(d)); err != nil {
+			log.Fatalf("Error generating %s: %v", d.Domain, err)
+		}
+	}
+	if err := g.writeFile(filepath.Join(*out, "events.go"), g.eventsFile(domains)); err != nil {
+		log.Fatalf("Error generating events: %v", err)
+	}
+}
+
+func readSchema(path string) (*schema, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var s schema
+	if err := json.Unmarshal(data, &s); err != nil {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+	return &s, nil
+}
+
+type generator struct {
+	// types maps qualified type IDs ("Runtime.RemoteObject") to their
+	// descriptions
+	types map[string]*typeDesc
+}
+
+func newGenerator(domains []*domain) *generator {
+	g := &generator{types: make(map[string]*typeDesc)}
+	for _, d := range domains {
+		for _, t := range d.Types {
+			g.types[d.Domain+"."+t.ID] = t
+		}
+	}
+	return g
+}
+
+func (g *generator) writeFile(path string, src []byte) error {
+	formatted, err := format.Source(src)
+	if err != nil {
+		return fmt.Errorf("formatting generated code: %v\n%s", err, src)
+	}
+	return os.WriteFile(path, formatted, 0644)
+}
+
+func header(buf *bytes.Buffer, imports ...string) {
+	buf.WriteString("// Code generated by cdpgen. DO NOT EDIT.\n\npackage protocol\n\n")
+	if len(imports) == 0 {
+		return
+	}
+	buf.WriteString("import (\n")
+	for _, path := range imports {
+		fmt.Fprintf(buf, "%q\n", path)
+	}
+	buf.WriteString(")\n\n")
+}
+
+func (g *generator) domainFile(d *domain) []byte {
+	var body bytes.Buffer
+
+	if len(d.Commands) > 0 || len(d.Events) > 0 {
+		body.WriteString("const (\n")
+		for _, c := range d.Commands {
+			fmt.Fprintf(&body, "Command%s%s = %q\n", d.Domain, exported(c.Name), d.Domain+"."+c.Name)
+		}
+		for _, e := range d.Events {
+			fmt.Fprintf(&body, "Event%s%s = %q\n", d.Domain, exported(e.Name), d.Domain+"."+e.Name)
+		}
+		body.WriteString(")\n\n")
+	}
+
+	for _, t := range d.Types {
+		name := d.Domain + exported(t.ID)
+		comment(&body, name, t.Description)
+		if t.Type == "object" && len(t.Properties) > 0 {
+			fmt.Fprintf(&body, "type %s struct {\n", name)
+			g.fields(&body, d, t.Properties)
+			body.WriteString("}\n\n")
+		} else {
+			fmt.Fprintf(&body, "type %s = %s\n\n", name, g.goType(d, t, false))
+		}
+	}
+
+	for _, c := range d.Commands {
+		name := d.Domain + exported(c.Name)
+		if len(c.Parameters) > 0 {
+			comment(&body, name+"Params", "are the parameters of "+d.Domain+"."+c.Name+". "+c.Description)
+			g.structType(&body, d, name+"Params", c.Parameters)
+		}
+		if len(c.Returns) > 0 {
+			comment(&body, name+"Returns", "is the result of "+d.Domain+"."+c.Name+".")
+			g.structType(&body, d, name+"Returns", c.Returns)
+		}
+	}
+
+	for _, e := range d.Events {
+		name := d.Domain + exported(e.Name) + "Event"
+		comment(&body, name, "is the "+d.Domain+"."+e.Name+" event. "+e.Description)
+		g.structType(&body, d, name, e.Parameters)
+	}
+
+	var buf bytes.Buffer
+	if bytes.Contains(body.Bytes(), []byte("json.RawMessage")) {
+		header(&buf, "encoding/json")
+	} else {
+		header(&buf)
+	}
+	if d.Description != "" {
+		fmt.Fprintf(&buf, "// %s domain: %s\n\n", d.Domain, oneLine(d.Description))
+	}
+	buf.Write(body.Bytes())
+	return buf.Bytes()
+}
+
+// eventsFile generates UnmarshalEvent, which decodes the params of any
+// generated event into its struct
+func (g *generator) eventsFile(domains []*domain) []byte {
+	var buf bytes.Buffer
+	header(&buf, "encoding/json", "fmt")
+
+	buf.WriteString("// UnmarshalEvent decodes the params of an event into a pointer to its\n")
+	buf.WriteString("// event struct, such as *RuntimeConsoleAPICalledEvent for\n")
+	buf.WriteString("// \"Runtime.consoleAPICalled\".\n")
+	buf.WriteString("func UnmarshalEvent(method string, params json.RawMessage) (interface{}, error) {\n")
+	buf.WriteString("var event interface{}\nswitch method {\n")
+
+	var names []string
+	for _, d := range domains {
+		for _, e := range d.Events {
+			names = append(names, d.Domain+exported(e.Name))
+		}
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintf(&buf, "case Event%s:\nevent = &%sEvent{}\n", name, name)
+	}
+
+	buf.WriteString("default:\nreturn nil, fmt.Errorf(\"unknown event %s\", method)\n}\n")
+	buf.WriteString("if len(params) > 0 {\n")
+	buf.WriteString("if err := json.Unmarshal(params, event); err != nil {\n")
+	buf.WriteString("return nil, fmt.Errorf(\"decoding %s: %v\", method, err)\n}\n}\n")
+	buf.WriteString("return event, nil\n}\n")
+	return buf.Bytes()
+}
+
+func (g *generator) structType(buf *bytes.Buffer, d *domain, name string, props []*typeDesc) {
+	fmt.Fprintf(buf, "type %s struct {\n", name)
+	g.fields(buf, d, props)
+	buf.WriteString("}\n\n")
+}
+
+func (g *generator) fields(buf *bytes.Buffer, d *domain, props []*typeDesc) {
+	for _, p := range props {
+		if p.Description != "" {
+			fmt.Fprintf(buf, "// %s\n", oneLine(p.Description))
+		}
+		tag := p.Name
+		if p.Optional {
+			tag += ",omitempty"
+		}
+		fmt.Fprintf(buf, "%s %s `json:%q`\n", exported(p.Name), g.goType(d, p, p.Optional), tag)
+	}
+}
+
+// goType is the Go type of t within domain d. Optional object types are
+// pointers so absent ones stay nil.
+func (g *generator) goType(d *domain, t *typeDesc, optional bool) string {
+	if t.Ref != "" {
+		id := t.Ref
+		if !strings.Contains(id, ".") {
+			id = d.Domain + "." + id
+		}
+		ref, ok := g.types[id]
+		if !ok {
+			return "json.RawMessage"
+		}
+		name := strings.Replace(id, ".", "", 1)
+		name = name[:len(name)-len(ref.ID)] + exported(ref.ID)
+		if optional && ref.Type == "object" && len(ref.Properties) > 0 {
+			return "*" + name
+		}
+		return name
+	}
+
+	switch t.Type {
+	case "string":
+		return "string"
+	case "integer":
+		return "int"
+	case "number":
+		return "float64"
+	case "boolean":
+		return "bool"
+	case "any":
+		return "interface{}"
+	case "array":
+		if t.Items == nil {
+			return "[]json.RawMessage"
+		}
+		return "[]" + g.goType(d, t.Items, false)
+	}
+	// Objects without declared properties are free-form
+	return "json.RawMessage"
+}
+
+// exported turns a protocol name into an exported Go name
+func exported(name string) string {
+	if name == "" {
+		return name
+	}
+	runes := []rune(name)
+	runes[0] = unicode.ToUpper(runes[0])
+	name = string(runes)
+
+	var out strings.Builder
+	for i := 0; i < len(name); {
+		matched := false
+		for word, initialism := range initialisms {
+			if !strings.HasPrefix(name[i:], word) {
+				continue
+			}
+			end := i + len(word)
+			// Only whole words: "Id" in "frameId" but not in "Identifier"
+			if end < len(name) && !unicode.IsUpper(rune(name[end])) && !unicode.IsDigit(rune(name[end])) {
+				continue
+			}
+			if i > 0 && unicode.IsUpper(rune(name[i-1])) {
+				continue
+			}
+			out.WriteString(initialism)
+			i = end
+			matched = true
+			break
+		}
+		if !matched {
+			out.WriteByte(name[i])
+			i++
+		}
+	}
+	return out.String()
+}
+
+func comment(buf *bytes.Buffer, name, description string) {
+	description = strings.TrimSpace(oneLine(description))
+	if description == "" {
+		return
+	}
+	if strings.HasPrefix(description, "are ") || strings.HasPrefix(description, "is ") {
+		fmt.Fprintf(buf, "// %s %s\n", name, description)
+		return
+	}
+	fmt.Fprintf(buf, "// %s: %s\n", name, description)
+}
+
+func oneLine(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
@@ -0,0 +1,97 @@
+package config
+
+import (
+	"os"
+	"strconv"
+	"strings"
+)
+
+// DefaultChromeEndpoint is where Chrome listens with --remote-debugging-port=9222
+const DefaultChromeEndpoint = "http://localhost:9222"
+
+// Endpoint is a named Chrome remote debugging address
+type Endpoint struct {
+	Name string `json:"name"`
+	URL  string `json:"url"`
+}
+
+// Config holds server-wide settings read from the environment
+type Config struct {
+	// SourceMapDir is a local build output directory (e.g. "./.next") that
+	// source maps are read from before falling back to sourceMappingURL
+	SourceMapDir string
+
+	// Endpoints are the browsers radar can attach to, the first one is used
+	// when a request does not name one. Set with RADAR_CHROME_ENDPOINTS as
+	// "name=url,name=url", or a single RADAR_CHROME_URL.
+	Endpoints []Endpoint
+
+	// CaptureConcurrency is how many targets a request captures at once
+	// unless it asks otherwise (RADAR_CAPTURE_CONCURRENCY)
+	CaptureConcurrency int
+
+	// ValueDepth and ValueProperties limit how deep console arguments are
+	// expanded and how many properties each object keeps
+	// (RADAR_VALUE_DEPTH, RADAR_VALUE_PROPERTIES)
+	ValueDepth      int
+	ValueProperties int
+
+	// MaxMessages, MaxMessageBytes and MaxTotalBytes cap what a capture keeps
+	// of each target (RADAR_MAX_MESSAGES, RADAR_MAX_MESSAGE_BYTES,
+	// RADAR_MAX_TOTAL_BYTES)
+	MaxMessages     int
+	MaxMessageBytes int
+	MaxTotalBytes   int
+}
+
+// Load reads the configuration from environment variables
+func Load() *Config {
+	return &Config{
+		SourceMapDir: os.Getenv("RADAR_SOURCE_MAP_DIR"),
+		Endpoints:    loadEndpoints(),
+
+		CaptureConcurrency: intFromEnv("RADAR_CAPTURE_CONCURRENCY", 4),
+		ValueDepth:         intFromEnv("RADAR_VALUE_DEPTH", 3),
+		ValueProperties:    intFromEnv("RADAR_VALUE_PROPERTIES", 50),
+		MaxMessages:        intFromEnv("RADAR_MAX_MESSAGES", 5000),
+		MaxMessageBytes:    intFromEnv("RADAR_MAX_MESSAGE_BYTES", 16<<10),
+		MaxTotalBytes:      intFromEnv("RADAR_MAX_TOTAL_BYTES", 8<<20),
+	}
+}
+
+// Endpoint looks up a configured endpoint by name
+func (c *Config) Endpoint(name string) (Endpoint, bool) {
+	for _, endpoint := range c.Endpoints {
+		if endpoint.Name == name {
+			return endpoint, true
+		}
+	}
+	return Endpoint{}, false
+}
+
+func intFromEnv(name string, fallback int) int {
+	if value, err := strconv.Atoi(os.Getenv(name)); err == nil && value > 0 {
+		return value
+	}
+	return fallback
+}
+
+func loadEndpoints() []Endpoint {
+	var endpoints []Endpoint
+	for _, entry := range strings.Split(os.Getenv("RADAR_CHROME_ENDPOINTS"), ",") {
+		name, url, ok := strings.Cut(strings.TrimSpace(entry), "=")
+		if !ok || name == "" || url == "" {
+			continue
+		}
+		endpoints = append(endpoints, Endpoint{Name: name, URL: url})
+	}
+	if len(endpoints) > 0 {
+		return endpoints
+	}
+
+	url := os.Getenv("RADAR_CHROME_URL")
+	if url == "" {
+		url = DefaultChromeEndpoint
+	}
+	return []Endpoint{{Name: "default", URL: url}}
+}
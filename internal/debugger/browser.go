@@ -0,0 +1,179 @@
+package debugger
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"debugger-api/internal/protocol"
+)
+
+// browserCallTimeout bounds browser-level commands and endpoint requests
+const browserCallTimeout = 10 * time.Second
+
+// Browser lists, opens and attaches to the targets of one browser, either
+// through the /json HTTP endpoint (ChromeDebugger) or a browser-level CDP
+// websocket as exposed by Puppeteer and Playwright (BrowserDebugger)
+type Browser interface {
+	Version(ctx context.Context) (*BrowserVersion, error)
+	FindTargets(ctx context.Context, matchers []TargetMatcher) (map[string][]*DebuggingTarget, error)
+	OpenTarget(ctx context.Context, url string) (*DebuggingTarget, error)
+	CloseTarget(ctx context.Context, id string) error
+	Attach(ctx context.Context, target *DebuggingTarget) (*TargetConn, error)
+	Close() error
+}
+
+// TargetConn is an attached target. Page sends commands to it; its events
+// arrive on Client tagged with SessionID, which is empty when the target
+// has a websocket of its own.
+type TargetConn struct {
+	Client    *CDPClient
+	Page      Caller
+	SessionID string
+
+	close func() error
+}
+
+// Close detaches from the target
+func (t *TargetConn) Close() error {
+	return t.close()
+}
+
+// ConnectBrowser returns the Browser for an endpoint: ws:// and wss:// URLs
+// are dialed as browser-level websockets, anything else is treated as a
+// remote debugging HTTP address
+func ConnectBrowser(ctx context.Context, endpoint string) (Browser, error) {
+	if strings.HasPrefix(endpoint, "ws://") || strings.HasPrefix(endpoint, "wss://") {
+		return DialBrowser(ctx, endpoint)
+	}
+	return NewChromeDebugger(endpoint), nil
+}
+
+// BrowserDebugger drives a browser over its browser-level websocket. Pages
+// are discovered with Target.getTargets and attached in flatten mode, so all
+// of them share the one connection.
+type BrowserDebugger struct {
+	client *CDPClient
+}
+
+// DialBrowser connects to a browser's webSocketDebuggerUrl
+func DialBrowser(ctx context.Context, wsURL string) (*BrowserDebugger, error) {
+	client, err := DialCDP(ctx, wsURL)
+	if err != nil {
+		return nil, err
+	}
+	return &BrowserDebugger{client: client}, nil
+}
+
+// Version asks the browser for its metadata
+func (b *BrowserDebugger) Version(ctx context.Context) (*BrowserVersion, error) {
+	ctx, cancel := context.WithTimeout(ctx, browserCallTimeout)
+	defer cancel()
+
+	var reply protocol.BrowserGetVersionReturns
+	if err := b.client.Call(ctx, protocol.CommandBrowserGetVersion, nil, &reply); err != nil {
+		return nil, fmt.Errorf("error getting browser version: %v", err)
+	}
+	return &BrowserVersion{
+		Browser:         reply.Product,
+		ProtocolVersion: reply.ProtocolVersion,
+		UserAgent:       reply.UserAgent,
+		V8Version:       reply.JSVersion,
+	}, nil
+}
+
+// ListTargets returns every target the browser knows about
+func (b *BrowserDebugger) ListTargets(ctx context.Context) ([]DebuggingTarget, error) {
+	ctx, cancel := context.WithTimeout(ctx, browserCallTimeout)
+	defer cancel()
+
+	var reply protocol.TargetGetTargetsReturns
+	if err := b.client.Call(ctx, protocol.CommandTargetGetTargets, nil, &reply); err != nil {
+		return nil, fmt.Errorf("error getting debug targets: %v", err)
+	}
+
+	targets := make([]DebuggingTarget, len(reply.TargetInfos))
+	for i, info := range reply.TargetInfos {
+		targets[i] = DebuggingTarget{
+			ID:    info.TargetID,
+			Type:  info.Type,
+			Title: info.Title,
+			URL:   info.URL,
+		}
+	}
+	return targets, nil
+}
+
+// FindTargets returns every target each matcher selects, keyed by the
+// matcher's Key
+func (b *BrowserDebugger) FindTargets(ctx context.Context, matchers []TargetMatcher) (map[string][]*DebuggingTarget, error) {
+	targets, err := b.ListTargets(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return matchTargets(targets, matchers), nil
+}
+
+// OpenTarget creates a blank tab that capture navigates to url once attached
+func (b *BrowserDebugger) OpenTarget(ctx context.Context, url string) (*DebuggingTarget, error) {
+	ctx, cancel := context.WithTimeout(ctx, browserCallTimeout)
+	defer cancel()
+
+	fmt.Printf("🆕 Opening new tab for %s\n", url)
+	var reply protocol.TargetCreateTargetReturns
+	params := protocol.TargetCreateTargetParams{URL: "about:blank"}
+	if err := b.client.Call(ctx, protocol.CommandTargetCreateTarget, params, &reply); err != nil {
+		return nil, fmt.Errorf("error opening tab: %v", err)
+	}
+
+	return &DebuggingTarget{
+		ID:      reply.TargetID,
+		Type:    "page",
+		URL:     "about:blank",
+		OpenURL: normalizeURL(url),
+	}, nil
+}
+
+// CloseTarget closes a tab by target ID
+func (b *BrowserDebugger) CloseTarget(ctx context.Context, id string) error {
+	ctx, cancel := context.WithTimeout(ctx, browserCallTimeout)
+	defer cancel()
+
+	params := protocol.TargetCloseTargetParams{TargetID: id}
+	if err := b.client.Call(ctx, protocol.CommandTargetCloseTarget, params, nil); err != nil {
+		return fmt.Errorf("error closing tab: %v", err)
+	}
+	return nil
+}
+
+// Attach attaches to the target over a flattened session
+func (b *BrowserDebugger) Attach(ctx context.Context, target *DebuggingTarget) (*TargetConn, error) {
+	var reply protocol.TargetAttachToTargetReturns
+	params := protocol.TargetAttachToTargetParams{
+		TargetID: target.ID,
+		Flatten:  true,
+	}
+	if err := b.client.Call(ctx, protocol.CommandTargetAttachToTarget, params, &reply); err != nil {
+		return nil, fmt.Errorf("error attaching to target %s: %v", target.ID, err)
+	}
+
+	detach := func() error {
+		ctx, cancel := context.WithTimeout(context.Background(), browserCallTimeout)
+		defer cancel()
+		params := protocol.TargetDetachFromTargetParams{SessionID: reply.SessionID}
+		return b.client.Call(ctx, protocol.CommandTargetDetachFromTarget, params, nil)
+	}
+
+	return &TargetConn{
+		Client:    b.client,
+		Page:      b.client.Session(reply.SessionID),
+		SessionID: reply.SessionID,
+		close:     detach,
+	}, nil
+}
+
+// Close drops the browser connection
+func (b *BrowserDebugger) Close() error {
+	return b.client.Close()
+}
@@ -0,0 +1,447 @@
+package debugger
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	"github.com/gorilla/websocket"
+)
+
+// ErrClientClosed is returned for calls made after the connection went away
+var ErrClientClosed = errors.New("cdp client closed")
+
+// CDPError is an error reply sent back by the browser for a command
+type CDPError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	Data    string `json:"data,omitempty"`
+}
+
+func (e *CDPError) Error() string {
+	if e.Data != "" {
+		return fmt.Sprintf("cdp error %d: %s (%s)", e.Code, e.Message, e.Data)
+	}
+	return fmt.Sprintf("cdp error %d: %s", e.Code, e.Message)
+}
+
+// CDPEvent is a protocol event pushed by the browser. SessionID is set for
+// events from targets attached over a flattened session.
+type CDPEvent struct {
+	Method    string          `json:"method"`
+	Params    json.RawMessage `json:"params"`
+	SessionID string          `json:"sessionId,omitempty"`
+}
+
+// cdpReply is the reply to a command
+type cdpReply struct {
+	Result json.RawMessage
+	Error  *CDPError
+}
+
+type cdpCommand struct {
+	ID        int64       `json:"id"`
+	Method    string      `json:"method"`
+	Params    interface{} `json:"params,omitempty"`
+	SessionID string      `json:"sessionId,omitempty"`
+}
+
+// Caller sends commands to a target, either directly over its own websocket
+// (CDPClient) or through a flattened session on a shared one (CDPSession)
+type Caller interface {
+	Call(ctx context.Context, method string, params interface{}, result interface{}) error
+}
+
+// CDPClient owns a single websocket to a debugging target. A dedicated reader
+// goroutine routes command replies back to their callers by id and fans
+// events out to subscribers, so callers never have to read the socket.
+type CDPClient struct {
+	conn    *websocket.Conn
+	writeMu sync.Mutex
+
+	mu      sync.Mutex
+	nextID  int64
+	pending map[int64]chan *cdpReply
+	subs    map[*Subscription]struct{}
+	err     error
+
+	done      chan struct{}
+	closeOnce sync.Once
+
+	// names interns event methods and session IDs; only the reader uses it
+	names map[string]string
+}
+
+// DialCDP connects to a target's webSocketDebuggerUrl and starts the reader
+func DialCDP(ctx context.Context, wsURL string) (*CDPClient, error) {
+	conn, _, err := websocket.DefaultDialer.DialContext(ctx, wsURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("websocket connection error: %v", err)
+	}
+
+	c := &CDPClient{
+		conn:    conn,
+		pending: make(map[int64]chan *cdpReply),
+		subs:    make(map[*Subscription]struct{}),
+		done:    make(chan struct{}),
+	}
+	go c.readLoop()
+	return c, nil
+}
+
+// Call sends a command and waits for its reply. When result is non-nil the
+// reply's result object is decoded into it.
+func (c *CDPClient) Call(ctx context.Context, method string, params interface{}, result interface{}) error {
+	return c.call(ctx, "", method, params, result)
+}
+
+// Session returns a Caller for a target attached with flatten: true
+func (c *CDPClient) Session(sessionID string) *CDPSession {
+	return &CDPSession{client: c, ID: sessionID}
+}
+
+func (c *CDPClient) call(ctx context.Context, sessionID, method string, params interface{}, result interface{}) error {
+	replyCh := make(chan *cdpReply, 1)
+
+	c.mu.Lock()
+	if c.err != nil {
+		err := c.err
+		c.mu.Unlock()
+		return err
+	}
+	c.nextID++
+	id := c.nextID
+	c.pending[id] = replyCh
+	c.mu.Unlock()
+
+	defer func() {
+		c.mu.Lock()
+		delete(c.pending, id)
+		c.mu.Unlock()
+	}()
+
+	c.writeMu.Lock()
+	err := c.conn.WriteJSON(cdpCommand{ID: id, Method: method, Params: params, SessionID: sessionID})
+	c.writeMu.Unlock()
+	if err != nil {
+		return fmt.Errorf("failed to send %s: %v", method, err)
+	}
+
+	select {
+	case reply := <-replyCh:
+		if reply.Error != nil {
+			return reply.Error
+		}
+		if result != nil && len(reply.Result) > 0 {
+			if err := json.Unmarshal(reply.Result, result); err != nil {
+				return fmt.Errorf("failed to decode %s result: %v", method, err)
+			}
+		}
+		return nil
+	case <-c.done:
+		return c.Err()
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Subscribe registers for events with the given method names. With no
+// methods every event is delivered.
+func (c *CDPClient) Subscribe(methods ...string) *Subscription {
+	sub := newSubscription(c, methods)
+
+	c.mu.Lock()
+	if c.err != nil {
+		c.mu.Unlock()
+		sub.end()
+		return sub
+	}
+	c.subs[sub] = struct{}{}
+	c.mu.Unlock()
+
+	return sub
+}
+
+// Done is closed once the connection is gone
+func (c *CDPClient) Done() <-chan struct{} {
+	return c.done
+}
+
+// Err reports why the connection went away, if it did
+func (c *CDPClient) Err() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.err
+}
+
+// Close shuts the websocket down and releases all waiting callers
+func (c *CDPClient) Close() error {
+	err := c.conn.Close()
+	c.shutdown(ErrClientClosed)
+	return err
+}
+
+// readLoop reads every message into a pooled buffer. Only what outlives
+// the buffer, a reply's result or the params of an event someone
+// subscribed to, is copied out of it.
+func (c *CDPClient) readLoop() {
+	for {
+		buf := getReadBuffer()
+		_, r, err := c.conn.NextReader()
+		if err == nil {
+			_, err = buf.ReadFrom(r)
+		}
+		if err != nil {
+			c.shutdown(err)
+			return
+		}
+
+		c.handleMessage(buf.Bytes())
+		putReadBuffer(buf)
+	}
+}
+
+func (c *CDPClient) handleMessage(data []byte) {
+	msg, err := scanMessage(data)
+	if err != nil {
+		fmt.Printf("⚠️ Dropping malformed CDP message: %v\n", err)
+		return
+	}
+
+	if msg.id != 0 {
+		c.mu.Lock()
+		replyCh, ok := c.pending[msg.id]
+		c.mu.Unlock()
+		if !ok {
+			return
+		}
+
+		reply := &cdpReply{Result: bytes.Clone(msg.result)}
+		if msg.error != nil {
+			reply.Error = &CDPError{}
+			if err := json.Unmarshal(msg.error, reply.Error); err != nil {
+				reply.Error.Message = string(msg.error)
+			}
+		}
+		replyCh <- reply
+		return
+	}
+
+	if len(msg.method) > 0 {
+		c.dispatch(&msg)
+	}
+}
+
+func (c *CDPClient) dispatch(msg *wireMessage) {
+	// Most events go to one or two subscribers
+	var wanting [4]*Subscription
+	subs := wanting[:0]
+
+	c.mu.Lock()
+	for sub := range c.subs {
+		if sub.wants(msg.method) {
+			subs = append(subs, sub)
+		}
+	}
+	c.mu.Unlock()
+
+	if len(subs) == 0 {
+		return
+	}
+
+	event := &CDPEvent{
+		Method:    c.intern(msg.method),
+		Params:    bytes.Clone(msg.params),
+		SessionID: c.intern(msg.sessionID),
+	}
+	for _, sub := range subs {
+		sub.push(event)
+	}
+}
+
+// maxInterned bounds the names cache; session IDs come and go with targets
+const maxInterned = 1024
+
+// intern returns b as a string, reusing the string from earlier events
+func (c *CDPClient) intern(b []byte) string {
+	if len(b) == 0 {
+		return ""
+	}
+	if s, ok := c.names[string(b)]; ok {
+		return s
+	}
+	if c.names == nil || len(c.names) >= maxInterned {
+		c.names = make(map[string]string)
+	}
+	s := string(b)
+	c.names[s] = s
+	return s
+}
+
+func (c *CDPClient) shutdown(reason error) {
+	c.closeOnce.Do(func() {
+		c.mu.Lock()
+		c.err = reason
+		subs := c.subs
+		c.subs = make(map[*Subscription]struct{})
+		c.mu.Unlock()
+
+		close(c.done)
+		for sub := range subs {
+			sub.end()
+		}
+	})
+}
+
+func (c *CDPClient) unsubscribe(sub *Subscription) {
+	c.mu.Lock()
+	delete(c.subs, sub)
+	c.mu.Unlock()
+}
+
+// CDPSession is a target attached over a flattened session of a CDPClient.
+// Its events arrive on the client's subscriptions tagged with ID.
+type CDPSession struct {
+	client *CDPClient
+	ID     string
+}
+
+// Call sends a command to the session's target
+func (s *CDPSession) Call(ctx context.Context, method string, params interface{}, result interface{}) error {
+	return s.client.call(ctx, s.ID, method, params, result)
+}
+
+// maxQueuedEvents is how many events a subscription holds for a consumer
+// that falls behind before it starts dropping them
+const maxQueuedEvents = 10000
+
+// Subscription delivers events in arrival order on C. Events are queued so
+// a slow consumer never stalls the reader goroutine (and with it the
+// replies the consumer may itself be waiting on); past maxQueuedEvents new
+// console and log messages are dropped and counted. Every other event, such
+// as Page, Network, Debugger and target lifecycle events, is always queued.
+// C is closed when the subscription is cancelled or the connection goes
+// away.
+type Subscription struct {
+	C <-chan *CDPEvent
+
+	client  *CDPClient
+	methods map[string]bool
+	in      chan *CDPEvent
+	out     chan *CDPEvent
+	quit    chan struct{}
+	ended   chan struct{}
+	qOnce   sync.Once
+	eOnce   sync.Once
+	dropped atomic.Int64
+}
+
+func newSubscription(c *CDPClient, methods []string) *Subscription {
+	sub := &Subscription{
+		client: c,
+		in:     make(chan *CDPEvent, 64),
+		out:    make(chan *CDPEvent),
+		quit:   make(chan struct{}),
+		ended:  make(chan struct{}),
+	}
+	sub.C = sub.out
+	if len(methods) > 0 {
+		sub.methods = make(map[string]bool, len(methods))
+		for _, m := range methods {
+			sub.methods[m] = true
+		}
+	}
+	go sub.pump()
+	return sub
+}
+
+// Cancel stops delivery and closes C
+func (s *Subscription) Cancel() {
+	s.client.unsubscribe(s)
+	s.stop()
+}
+
+// Dropped is how many events were dropped because the queue was full
+func (s *Subscription) Dropped() int {
+	return int(s.dropped.Load())
+}
+
+func (s *Subscription) wants(method []byte) bool {
+	return s.methods == nil || s.methods[string(method)]
+}
+
+func (s *Subscription) push(event *CDPEvent) {
+	select {
+	case s.in <- event:
+	case <-s.quit:
+	case <-s.ended:
+	}
+}
+
+// stop cancels delivery immediately
+func (s *Subscription) stop() {
+	s.qOnce.Do(func() { close(s.quit) })
+}
+
+// end lets queued events drain before C is closed
+func (s *Subscription) end() {
+	s.eOnce.Do(func() { close(s.ended) })
+}
+
+func (s *Subscription) pump() {
+	defer close(s.out)
+
+	var queue []*CDPEvent
+	for {
+		var out chan *CDPEvent
+		var next *CDPEvent
+		if len(queue) > 0 {
+			out = s.out
+			next = queue[0]
+		}
+
+		select {
+		case event := <-s.in:
+			if len(queue) >= maxQueuedEvents && droppableEvents[event.Method] {
+				s.dropped.Add(1)
+				continue
+			}
+			queue = append(queue, event)
+		case out <- next:
+			queue[0] = nil
+			queue = queue[1:]
+		case <-s.ended:
+			// The connection is gone: hand over what already arrived
+		drain:
+			for {
+				select {
+				case event := <-s.in:
+					queue = append(queue, event)
+				default:
+					break drain
+				}
+			}
+			for _, event := range queue {
+				select {
+				case s.out <- event:
+				case <-s.quit:
+					return
+				}
+			}
+			return
+		case <-s.quit:
+			return
+		}
+	}
+}
+
+// droppableEvents are the high-volume message events a full queue drops
+var droppableEvents = map[string]bool{
+	"Runtime.consoleAPICalled": true,
+	"Console.messageAdded":     true,
+	"Log.entryAdded":           true,
+}
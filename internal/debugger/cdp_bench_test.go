@@ -0,0 +1,126 @@
+package debugger
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/json"
+	"os"
+	"testing"
+)
+
+// floodTrace is a CDP trace of a page logging from a setInterval: console
+// calls from the page and a worker with their Console.messageAdded copies,
+// polling requests with every Network event Chrome sends for them, log
+// violations, exceptions and stray command replies, 5366 messages in all.
+const floodTrace = "testdata/console_flood.jsonl.gz"
+
+// captureMethods are the events a capture subscribes to
+var captureMethods = []string{
+	"Runtime.consoleAPICalled",
+	"Runtime.exceptionThrown",
+	"Runtime.exceptionRevoked",
+	"Debugger.scriptParsed",
+	"Network.requestWillBeSent",
+	"Network.responseReceived",
+	"Network.loadingFailed",
+	"Network.loadingFinished",
+	"Log.entryAdded",
+	"Audits.issueAdded",
+	"Page.loadEventFired",
+	"Page.frameNavigated",
+	"Target.attachedToTarget",
+	"Target.detachedFromTarget",
+	"Inspector.detached",
+	"Inspector.targetCrashed",
+	"Console.messageAdded",
+}
+
+func loadTrace(b testing.TB) ([][]byte, int64) {
+	f, err := os.Open(floodTrace)
+	if err != nil {
+		b.Fatal(err)
+	}
+	defer f.Close()
+
+	r, err := gzip.NewReader(f)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	var messages [][]byte
+	var size int64
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, 1<<20)
+	for scanner.Scan() {
+		messages = append(messages, append([]byte(nil), scanner.Bytes()...))
+		size += int64(len(scanner.Bytes()))
+	}
+	if err := scanner.Err(); err != nil {
+		b.Fatal(err)
+	}
+	return messages, size
+}
+
+func newBenchClient() *CDPClient {
+	return &CDPClient{
+		pending: make(map[int64]chan *cdpReply),
+		subs:    make(map[*Subscription]struct{}),
+		done:    make(chan struct{}),
+	}
+}
+
+// BenchmarkHandleMessage runs the trace through the reader's message path
+// with a capture subscribed, as one tab being captured would
+func BenchmarkHandleMessage(b *testing.B) {
+	trace, size := loadTrace(b)
+	c := newBenchClient()
+	sub := c.Subscribe(captureMethods...)
+	go func() {
+		for range sub.C {
+		}
+	}()
+	defer sub.Cancel()
+
+	b.SetBytes(size)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, data := range trace {
+			c.handleMessage(data)
+		}
+	}
+}
+
+// BenchmarkHandleMessageUnsubscribed measures what events nobody listens
+// to cost
+func BenchmarkHandleMessageUnsubscribed(b *testing.B) {
+	trace, size := loadTrace(b)
+	c := newBenchClient()
+
+	b.SetBytes(size)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, data := range trace {
+			c.handleMessage(data)
+		}
+	}
+}
+
+// BenchmarkGenericDecode is the baseline: every message decoded into
+// generic maps, as events were handled before the typed model
+func BenchmarkGenericDecode(b *testing.B) {
+	trace, size := loadTrace(b)
+
+	b.SetBytes(size)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, data := range trace {
+			var msg map[string]interface{}
+			if err := json.Unmarshal(data, &msg); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
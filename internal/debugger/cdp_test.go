@@ -0,0 +1,48 @@
+package debugger
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSubscriptionDropsOnlyMessageEvents(t *testing.T) {
+	sub := newSubscription(nil, nil)
+	defer sub.stop()
+
+	// Nobody reads C, so the queue fills up
+	for i := 0; i < maxQueuedEvents+5; i++ {
+		sub.push(&CDPEvent{Method: "Runtime.consoleAPICalled"})
+	}
+	sub.push(&CDPEvent{Method: "Console.messageAdded"})
+	sub.push(&CDPEvent{Method: "Log.entryAdded"})
+	// Events still waiting when the connection ends are all handed over
+	deadline := time.Now().Add(5 * time.Second)
+	for sub.Dropped() < 7 {
+		if time.Now().After(deadline) {
+			t.Fatalf("dropped %d events, want 7", sub.Dropped())
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	kept := []string{"Page.loadEventFired", "Network.requestWillBeSent", "Debugger.scriptParsed", "Target.detachedFromTarget"}
+	for _, method := range kept {
+		sub.push(&CDPEvent{Method: method})
+	}
+	sub.end()
+
+	var methods []string
+	for event := range sub.C {
+		methods = append(methods, event.Method)
+	}
+	if dropped := sub.Dropped(); dropped != 7 {
+		t.Errorf("dropped %d events, want 7", dropped)
+	}
+	if len(methods) != maxQueuedEvents+4 {
+		t.Fatalf("delivered %d events, want %d", len(methods), maxQueuedEvents+4)
+	}
+	for i, method := range kept {
+		if got := methods[maxQueuedEvents+i]; got != method {
+			t.Errorf("event %d is %s, want %s", maxQueuedEvents+i, got, method)
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package debugger
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -10,19 +11,45 @@ import (
 // ChromeDebugger handles communication with Chrome's debugging protocol
 type ChromeDebugger struct {
 	debugURL string
+	client   *http.Client
 }
 
-// NewChromeDebugger creates a new ChromeDebugger instance
-func NewChromeDebugger() *ChromeDebugger {
+// NewChromeDebugger creates a ChromeDebugger for a remote debugging
+// endpoint such as "http://localhost:9222"
+func NewChromeDebugger(endpoint string) *ChromeDebugger {
+	endpoint = strings.TrimSuffix(strings.TrimSuffix(endpoint, "/"), "/json")
 	return &ChromeDebugger{
-		debugURL: ChromeDebuggerURL,
+		debugURL: normalizeURL(endpoint) + "/json",
+		// An endpoint that accepts connections but never answers must not
+		// hold up requests that fan out over every browser
+		client: &http.Client{Timeout: browserCallTimeout},
 	}
 }
 
-// GetDebuggingTargets now accepts URLs to filter
-func (c *ChromeDebugger) GetDebuggingTargets(urls []string) (map[string]*DebuggingTarget, error) {
+// Version fetches the browser metadata from /json/version, which also
+// confirms the endpoint is reachable
+func (c *ChromeDebugger) Version(ctx context.Context) (*BrowserVersion, error) {
+	resp, err := c.get(ctx, c.debugURL+"/version")
+	if err != nil {
+		return nil, fmt.Errorf("error getting browser version: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("error getting browser version: %s", resp.Status)
+	}
+
+	var version BrowserVersion
+	if err := json.NewDecoder(resp.Body).Decode(&version); err != nil {
+		return nil, fmt.Errorf("error decoding browser version: %v", err)
+	}
+	return &version, nil
+}
+
+// ListTargets returns every target the browser currently exposes
+func (c *ChromeDebugger) ListTargets(ctx context.Context) ([]DebuggingTarget, error) {
 	fmt.Println("Fetching debugging targets...")
-	resp, err := http.Get(c.debugURL)
+	resp, err := c.get(ctx, c.debugURL)
 	if err != nil {
 		return nil, fmt.Errorf("error getting debug targets: %v", err)
 	}
@@ -32,18 +59,107 @@ func (c *ChromeDebugger) GetDebuggingTargets(urls []string) (map[string]*Debuggi
 	if err := json.NewDecoder(resp.Body).Decode(&targets); err != nil {
 		return nil, fmt.Errorf("error decoding targets: %v", err)
 	}
+	return targets, nil
+}
 
-	// Create a map of URL -> DebuggingTarget
-	urlTargets := make(map[string]*DebuggingTarget)
-	for _, url := range urls {
-		for i, target := range targets {
-			if target.Type == "page" && strings.Contains(target.URL, url) {
-				fmt.Printf("Found target for %s: %s\n", url, target.WebSocketDebuggerUrl)
-				urlTargets[url] = &targets[i]
-				break
+// FindTargets returns every target each matcher selects, keyed by the
+// matcher's Key. Matchers that select nothing are left out.
+func (c *ChromeDebugger) FindTargets(ctx context.Context, matchers []TargetMatcher) (map[string][]*DebuggingTarget, error) {
+	targets, err := c.ListTargets(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return matchTargets(targets, matchers), nil
+}
+
+// Attach opens a websocket to the target's own debugger URL
+func (c *ChromeDebugger) Attach(ctx context.Context, target *DebuggingTarget) (*TargetConn, error) {
+	client, err := DialCDP(ctx, target.WebSocketDebuggerUrl)
+	if err != nil {
+		return nil, err
+	}
+	return &TargetConn{Client: client, Page: client, close: client.Close}, nil
+}
+
+// Close is a no-op; the HTTP endpoint holds no connection
+func (c *ChromeDebugger) Close() error {
+	return nil
+}
+
+func matchTargets(targets []DebuggingTarget, matchers []TargetMatcher) map[string][]*DebuggingTarget {
+	matches := make(map[string][]*DebuggingTarget)
+	for i := range matchers {
+		key := matchers[i].Key()
+		for j := range targets {
+			if matchers[i].Matches(&targets[j]) {
+				fmt.Printf("Found target for %s: %s (%s)\n", key, targets[j].URL, targets[j].ID)
+				matches[key] = append(matches[key], &targets[j])
 			}
 		}
 	}
+	return matches
+}
+
+// OpenTarget opens a new tab for url. The tab starts on about:blank and
+// remembers url in OpenURL, so capture can attach before navigating there.
+func (c *ChromeDebugger) OpenTarget(ctx context.Context, url string) (*DebuggingTarget, error) {
+	fmt.Printf("🆕 Opening new tab for %s\n", url)
+	endpoint := c.debugURL + "/new?about:blank"
+
+	// Chrome 111+ only accepts PUT here, older versions only GET
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.client.Do(req)
+	if err == nil && resp.StatusCode == http.StatusMethodNotAllowed {
+		resp.Body.Close()
+		resp, err = c.get(ctx, endpoint)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error opening tab: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("error opening tab: %s", resp.Status)
+	}
+
+	var target DebuggingTarget
+	if err := json.NewDecoder(resp.Body).Decode(&target); err != nil {
+		return nil, fmt.Errorf("error decoding new target: %v", err)
+	}
+	target.OpenURL = normalizeURL(url)
+	return &target, nil
+}
 
-	return urlTargets, nil
+// CloseTarget closes a tab by target ID
+func (c *ChromeDebugger) CloseTarget(ctx context.Context, id string) error {
+	resp, err := c.get(ctx, c.debugURL+"/close/"+id)
+	if err != nil {
+		return fmt.Errorf("error closing tab: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("error closing tab: %s", resp.Status)
+	}
+	return nil
+}
+
+// get sends a GET request to the endpoint, bound to ctx
+func (c *ChromeDebugger) get(ctx context.Context, url string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	return c.client.Do(req)
+}
+
+// normalizeURL adds a scheme to URLs given as just "host:port/path"
+func normalizeURL(url string) string {
+	if strings.Contains(url, "://") || strings.HasPrefix(url, "about:") {
+		return url
+	}
+	return "http://" + url
 } 
\ No newline at end of file
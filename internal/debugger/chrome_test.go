@@ -0,0 +1,54 @@
+package debugger
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// TestEndpointHonoursContext checks that an endpoint which accepts the
+// connection but never answers does not outlive the caller's context
+func TestEndpointHonoursContext(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}))
+	defer server.Close()
+	defer close(release)
+
+	c := NewChromeDebugger(server.URL)
+	calls := map[string]func(ctx context.Context) error{
+		"Version": func(ctx context.Context) error {
+			_, err := c.Version(ctx)
+			return err
+		},
+		"FindTargets": func(ctx context.Context) error {
+			_, err := c.FindTargets(ctx, nil)
+			return err
+		},
+		"OpenTarget": func(ctx context.Context) error {
+			_, err := c.OpenTarget(ctx, "localhost:3000")
+			return err
+		},
+		"CloseTarget": func(ctx context.Context) error {
+			return c.CloseTarget(ctx, "ABC")
+		},
+	}
+	for name, call := range calls {
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		start := time.Now()
+		err := call(ctx)
+		cancel()
+		if err == nil {
+			t.Errorf("%s succeeded against a hanging endpoint", name)
+		}
+		if elapsed := time.Since(start); elapsed > time.Second {
+			t.Errorf("%s returned after %v", name, elapsed)
+		}
+	}
+}
@@ -0,0 +1,183 @@
+package debugger
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Match modes for TargetMatcher.Match and TargetMatcher.TitleMatch
+const (
+	MatchContains = "contains"
+	MatchExact    = "exact"
+	MatchPrefix   = "prefix"
+	MatchGlob     = "glob"
+	MatchRegex    = "regex"
+)
+
+// Key identifies the matcher in DebugResponse.Errors and stored sessions.
+// A plain URL matcher is keyed by its URL; the match modes, title and
+// types of other matchers are appended so no two different matchers share
+// a key.
+func (m *TargetMatcher) Key() string {
+	if m.ID != "" {
+		return "id:" + m.ID
+	}
+
+	var key string
+	var qualifiers []string
+	if m.URL != "" {
+		key = m.URL
+		if m.Match != "" && m.Match != MatchContains {
+			qualifiers = append(qualifiers, "match="+m.Match)
+		}
+		if m.Title != "" {
+			qualifiers = append(qualifiers, "title="+m.Title)
+		}
+	} else {
+		key = "title:" + m.Title
+	}
+	if m.Title != "" && m.TitleMatch != "" && m.TitleMatch != MatchContains {
+		qualifiers = append(qualifiers, "titleMatch="+m.TitleMatch)
+	}
+	if len(m.Types) > 0 && !(len(m.Types) == 1 && m.Types[0] == "page") {
+		qualifiers = append(qualifiers, "types="+strings.Join(m.Types, ","))
+	}
+
+	if len(qualifiers) == 0 {
+		return key
+	}
+	return key + " [" + strings.Join(qualifiers, " ") + "]"
+}
+
+// Compile validates the matcher and prepares its patterns
+func (m *TargetMatcher) Compile() error {
+	if m.URL == "" && m.Title == "" && m.ID == "" {
+		return fmt.Errorf("target matcher needs a url, title or id")
+	}
+
+	var err error
+	if m.urlPattern, err = compilePattern(m.URL, m.Match); err != nil {
+		return fmt.Errorf("invalid url pattern %q: %v", m.URL, err)
+	}
+	if m.titlePattern, err = compilePattern(m.Title, m.TitleMatch); err != nil {
+		return fmt.Errorf("invalid title pattern %q: %v", m.Title, err)
+	}
+	return nil
+}
+
+// Matches reports whether the target satisfies every set field
+func (m *TargetMatcher) Matches(target *DebuggingTarget) bool {
+	if !m.matchesType(target.Type) {
+		return false
+	}
+	if m.ID != "" && target.ID != m.ID {
+		return false
+	}
+	if m.URL != "" && !matchPattern(target.URL, m.URL, m.Match, m.urlPattern) {
+		return false
+	}
+	if m.Title != "" && !matchPattern(target.Title, m.Title, m.TitleMatch, m.titlePattern) {
+		return false
+	}
+	return true
+}
+
+// CanOpen reports whether a tab can be opened for this matcher when nothing
+// matches, which needs a concrete URL rather than a pattern
+func (m *TargetMatcher) CanOpen() bool {
+	if m.URL == "" || m.ID != "" || m.Title != "" {
+		return false
+	}
+	switch m.Match {
+	case "", MatchContains, MatchExact, MatchPrefix:
+		return true
+	}
+	return false
+}
+
+// matchesType checks the matcher's types. Without any, only pages match,
+// unless the matcher names the target by ID.
+func (m *TargetMatcher) matchesType(targetType string) bool {
+	if len(m.Types) == 0 {
+		return m.ID != "" || targetType == "page"
+	}
+	for _, t := range m.Types {
+		if t == "*" || t == targetType {
+			return true
+		}
+	}
+	return false
+}
+
+// Matchers returns the request's matchers compiled, with each plain URL
+// turned into a "contains" matcher on page targets. Matchers with the same
+// key would share their results, so duplicates are rejected.
+func (r *DebugRequest) Matchers() ([]TargetMatcher, error) {
+	matchers := make([]TargetMatcher, 0, len(r.URLs)+len(r.Targets))
+	for _, url := range r.URLs {
+		matchers = append(matchers, TargetMatcher{URL: url})
+	}
+	matchers = append(matchers, r.Targets...)
+
+	seen := make(map[string]bool, len(matchers))
+	for i := range matchers {
+		if err := matchers[i].Compile(); err != nil {
+			return nil, err
+		}
+		key := matchers[i].Key()
+		if seen[key] {
+			return nil, fmt.Errorf("duplicate target matcher %q", key)
+		}
+		seen[key] = true
+	}
+	return matchers, nil
+}
+
+func compilePattern(pattern, mode string) (*regexp.Regexp, error) {
+	if pattern == "" {
+		return nil, nil
+	}
+	switch mode {
+	case "", MatchContains, MatchExact, MatchPrefix:
+		return nil, nil
+	case MatchRegex:
+		return regexp.Compile(pattern)
+	case MatchGlob:
+		return regexp.Compile(globToRegex(pattern))
+	default:
+		return nil, fmt.Errorf("unknown match mode %q", mode)
+	}
+}
+
+func matchPattern(value, pattern, mode string, re *regexp.Regexp) bool {
+	switch mode {
+	case MatchExact:
+		return value == pattern
+	case MatchPrefix:
+		return strings.HasPrefix(value, pattern)
+	case MatchGlob, MatchRegex:
+		return re.MatchString(value)
+	default:
+		return strings.Contains(value, pattern)
+	}
+}
+
+// globToRegex translates a glob where "*" matches any run of characters
+// (including "/") and "?" a single character
+func globToRegex(glob string) string {
+	var re strings.Builder
+	re.WriteString("^")
+	for _, r := range glob {
+		switch r {
+		case '*':
+			re.WriteString(".*")
+		case '?':
+			re.WriteString(".")
+		default:
+			re.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	re.WriteString("$")
+	return re.String()
+}
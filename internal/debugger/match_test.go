@@ -0,0 +1,66 @@
+package debugger
+
+import "testing"
+
+func TestMatcherKey(t *testing.T) {
+	tests := []struct {
+		matcher TargetMatcher
+		want    string
+	}{
+		{TargetMatcher{URL: "localhost:3000"}, "localhost:3000"},
+		{TargetMatcher{URL: "localhost:3000", Match: MatchContains, Types: []string{"page"}}, "localhost:3000"},
+		{TargetMatcher{URL: "localhost:3000", Match: MatchExact}, "localhost:3000 [match=exact]"},
+		{TargetMatcher{URL: "localhost:3000", Types: []string{"service_worker"}}, "localhost:3000 [types=service_worker]"},
+		{TargetMatcher{URL: "localhost:3000", Title: "Admin", TitleMatch: MatchPrefix}, "localhost:3000 [title=Admin titleMatch=prefix]"},
+		{TargetMatcher{Title: "Admin"}, "title:Admin"},
+		{TargetMatcher{Title: "Admin*", TitleMatch: MatchGlob}, "title:Admin* [titleMatch=glob]"},
+		{TargetMatcher{ID: "ABC", URL: "localhost:3000"}, "id:ABC"},
+	}
+	for _, tt := range tests {
+		if got := tt.matcher.Key(); got != tt.want {
+			t.Errorf("Key(%+v) = %q, want %q", tt.matcher, got, tt.want)
+		}
+	}
+}
+
+func TestMatchersRejectDuplicates(t *testing.T) {
+	req := &DebugRequest{
+		URLs:    []string{"localhost:3000"},
+		Targets: []TargetMatcher{{URL: "localhost:3000", Match: MatchExact}},
+	}
+	if _, err := req.Matchers(); err != nil {
+		t.Fatalf("matchers with different modes: %v", err)
+	}
+
+	req.Targets = append(req.Targets, TargetMatcher{URL: "localhost:3000", Match: MatchContains})
+	if _, err := req.Matchers(); err == nil {
+		t.Error("duplicate matchers were accepted")
+	}
+}
+
+func TestMatchesType(t *testing.T) {
+	page := &DebuggingTarget{ID: "P", Type: "page", URL: "http://localhost:3000/"}
+	worker := &DebuggingTarget{ID: "W", Type: "service_worker", URL: "http://localhost:3000/sw.js"}
+
+	tests := []struct {
+		matcher TargetMatcher
+		target  *DebuggingTarget
+		want    bool
+	}{
+		{TargetMatcher{URL: "localhost:3000"}, page, true},
+		{TargetMatcher{URL: "localhost:3000"}, worker, false},
+		{TargetMatcher{URL: "localhost:3000", Types: []string{"service_worker"}}, worker, true},
+		{TargetMatcher{URL: "localhost:3000", Types: []string{"*"}}, worker, true},
+		// Naming a target by ID does not need its type
+		{TargetMatcher{ID: "W"}, worker, true},
+		{TargetMatcher{ID: "W", Types: []string{"page"}}, worker, false},
+	}
+	for _, tt := range tests {
+		if err := tt.matcher.Compile(); err != nil {
+			t.Fatal(err)
+		}
+		if got := tt.matcher.Matches(tt.target); got != tt.want {
+			t.Errorf("%+v matches %s target: %v, want %v", tt.matcher, tt.target.Type, got, tt.want)
+		}
+	}
+}
@@ -1,12 +1,11 @@
 package debugger
 
 import (
+	"encoding/json"
+	"regexp"
 	"time"
 )
 
-// Chrome DevTools Protocol (CDP) URL
-const ChromeDebuggerURL = "http://localhost:9222/json"
-
 // DebuggingTarget represents a Chrome debugging target
 type DebuggingTarget struct {
     ID                   string `json:"id"`
@@ -14,29 +13,363 @@ type DebuggingTarget struct {
     Title                string `json:"title"`
     URL                  string `json:"url"`
     WebSocketDebuggerUrl string `json:"webSocketDebuggerUrl"`
+
+    // OpenURL is set on tabs radar opened itself: the URL to navigate to
+    // once capture is attached
+    OpenURL string `json:"-"`
+}
+
+// BrowserVersion is what a Chrome endpoint reports at /json/version
+type BrowserVersion struct {
+    Browser              string `json:"Browser"`
+    ProtocolVersion      string `json:"Protocol-Version"`
+    UserAgent            string `json:"User-Agent"`
+    V8Version            string `json:"V8-Version"`
+    WebKitVersion        string `json:"WebKit-Version"`
+    WebSocketDebuggerURL string `json:"webSocketDebuggerUrl"`
 }
 
 // ConsoleMessage represents a structured console message
 type ConsoleMessage struct {
-    Type    string    `json:"type"`    // log, warn, error, info
-    Time    time.Time `json:"time"`
-    Message string    `json:"message"`
-    URL     string    `json:"url,omitempty"`
+    Type       string            `json:"type"`              // log, warning, error, info, debug, exception, issue
+    APIType    string            `json:"apiType,omitempty"` // console method as Runtime reports it: log, table, startGroup, assert, ...
+    Time       time.Time         `json:"time"`
+    Message    string            `json:"message"`
+    Args       []*RemoteValue    `json:"args,omitempty"`  // console API arguments as value trees
+    Spans      []StyledSpan      `json:"spans,omitempty"` // Message split where %c changed the style
+
+    GroupID     int           `json:"groupId,omitempty"`     // set on console.group messages
+    ParentGroup int           `json:"parentGroup,omitempty"` // GroupID of the innermost open group
+    GroupDepth  int           `json:"groupDepth,omitempty"`  // how many groups are open
+    Table       *ConsoleTable `json:"table,omitempty"`
+    Timer       *ConsoleTimer `json:"timer,omitempty"`
+
+    URL        string            `json:"url,omitempty"`
+    Navigation int               `json:"navigation"`         // index into PageResults.Navigations, 0 before any
+    Source     string            `json:"source,omitempty"`   // console, runtime, log, audits
+    Target     *TargetInfo       `json:"target,omitempty"`   // set for messages from workers and iframes
+    Category   string            `json:"category,omitempty"` // log entry source or issue code
+    StackTrace *StackTrace       `json:"stackTrace,omitempty"`
+    Exception  *ExceptionDetails `json:"exception,omitempty"`
+    Issue      *Issue            `json:"issue,omitempty"`
+
+    // RepeatCount is how many times an identical message was logged, when it
+    // was more than once; Time is the first time and LastTime the last
+    RepeatCount int        `json:"repeatCount,omitempty"`
+    LastTime    *time.Time `json:"lastTime,omitempty"`
+    Truncated   bool       `json:"truncated,omitempty"` // text or argument strings were cut to the size limit
+}
+
+// ConsoleTable is the data of a console.table call. Rows are keyed by
+// column; primitive rows are listed under the "Value" column.
+type ConsoleTable struct {
+    Columns []string   `json:"columns"`
+    Rows    []TableRow `json:"rows"`
+}
+
+// TableRow is one row of a console.table, Index being the array index or
+// property name it came from
+type TableRow struct {
+    Index  string                  `json:"index"`
+    Values map[string]*RemoteValue `json:"values"`
+}
+
+// ConsoleTimer is the label and elapsed time console.timeEnd and
+// console.timeLog report
+type ConsoleTimer struct {
+    Label      string  `json:"label"`
+    DurationMs float64 `json:"durationMs"`
+}
+
+// StyledSpan is a run of message text with the CSS a %c directive set
+type StyledSpan struct {
+    Text  string `json:"text"`
+    Style string `json:"style,omitempty"`
+}
+
+// RemoteValue is a JavaScript value as a typed tree, expanded from a
+// Runtime.RemoteObject. Objects list their own properties, arrays and typed
+// arrays their items, and Maps and Sets their entries, down to the
+// configured depth.
+type RemoteValue struct {
+    Type           string           `json:"type"`              // object, function, undefined, string, number, boolean, symbol, bigint
+    Subtype        string           `json:"subtype,omitempty"` // array, null, node, regexp, date, map, set, error, typedarray, ...
+    ClassName      string           `json:"className,omitempty"`
+    Value          interface{}      `json:"value,omitempty"`          // strings, numbers and booleans
+    Unserializable string           `json:"unserializable,omitempty"` // NaN, Infinity, -0 and bigint literals
+    Description    string           `json:"description,omitempty"`
+    Size           int              `json:"size,omitempty"` // length of arrays and typed arrays, size of Maps and Sets
+    Properties     []RemoteProperty `json:"properties,omitempty"`
+    Items          []*RemoteValue   `json:"items,omitempty"`
+    Entries        []RemoteEntry    `json:"entries,omitempty"`
+    Truncated      bool             `json:"truncated,omitempty"` // not expanded, or not every property was
+    Error          *ErrorRecord     `json:"error,omitempty"`     // set for Error objects
+}
+
+// ErrorRecord is an Error object taken apart. Stack holds the frames of
+// its stack property; Properties its own properties other than the
+// standard ones, such as a "code".
+type ErrorRecord struct {
+    Name       string           `json:"name"`
+    Message    string           `json:"message"`
+    Stack      *StackTrace      `json:"stack,omitempty"`
+    Cause      *ErrorRecord     `json:"cause,omitempty"`
+    Properties []RemoteProperty `json:"properties,omitempty"`
+}
+
+// RemoteProperty is a named property of an object
+type RemoteProperty struct {
+    Name  string       `json:"name"`
+    Value *RemoteValue `json:"value"`
+}
+
+// RemoteEntry is a Map entry, or a Set entry without a key
+type RemoteEntry struct {
+    Key   *RemoteValue `json:"key,omitempty"`
+    Value *RemoteValue `json:"value"`
+}
+
+// Issue is an Audits domain issue, as listed in DevTools' Issues panel.
+// Details keeps the protocol's issue-specific payload untouched.
+type Issue struct {
+    Code            string           `json:"code"`
+    IssueID         string           `json:"issueId,omitempty"`
+    Details         json.RawMessage  `json:"details,omitempty"`
+    AffectedRequest *AffectedRequest `json:"affectedRequest,omitempty"`
+    AffectedCookie  *AffectedCookie  `json:"affectedCookie,omitempty"`
+}
+
+// AffectedRequest is the network request an issue refers to
+type AffectedRequest struct {
+    RequestID string `json:"requestId"`
+    URL       string `json:"url,omitempty"`
+}
+
+// AffectedCookie is the cookie an issue refers to
+type AffectedCookie struct {
+    Name   string `json:"name"`
+    Path   string `json:"path"`
+    Domain string `json:"domain"`
+}
+
+// TargetInfo identifies an auto-attached child target (worker, service
+// worker, out-of-process iframe) that a message came from
+type TargetInfo struct {
+    ID   string `json:"id"`
+    Type string `json:"type"`
+    URL  string `json:"url"`
+}
+
+// StackTrace is the JavaScript call stack a message was emitted from.
+// Parent links to the stack that scheduled the async operation, if any.
+type StackTrace struct {
+    Description string      `json:"description,omitempty"`
+    CallFrames  []CallFrame `json:"callFrames"`
+    Parent      *StackTrace `json:"parent,omitempty"`
+}
+
+// CallFrame is a single stack frame (zero-based line and column). When a
+// source map resolved the frame, the location points at the original source
+// and Generated keeps the bundled position it was mapped from.
+type CallFrame struct {
+    FunctionName string             `json:"functionName"`
+    URL          string             `json:"url"`
+    LineNumber   int                `json:"lineNumber"`
+    ColumnNumber int                `json:"columnNumber"`
+    ScriptID     string             `json:"scriptId,omitempty"`
+    Generated    *GeneratedPosition `json:"generated,omitempty"`
+}
+
+// GeneratedPosition is the position of a frame inside the bundled script
+type GeneratedPosition struct {
+    FunctionName string `json:"functionName"`
+    URL          string `json:"url"`
+    LineNumber   int    `json:"lineNumber"`
+    ColumnNumber int    `json:"columnNumber"`
+}
+
+// ExceptionDetails describes an uncaught exception or unhandled rejection
+type ExceptionDetails struct {
+    ExceptionID      int    `json:"exceptionId"`
+    Text             string `json:"text"`
+    Description      string `json:"description,omitempty"`
+    LineNumber       int    `json:"lineNumber"`
+    ColumnNumber     int    `json:"columnNumber"`
+    ScriptID         string `json:"scriptId,omitempty"`
+    URL              string `json:"url,omitempty"`
+    PromiseRejection bool   `json:"promiseRejection,omitempty"`
+    Revoked          bool   `json:"revoked,omitempty"`
+    RevokeReason     string `json:"revokeReason,omitempty"`
+}
+
+// NetworkFailure is a request that failed to load or got a 4xx/5xx status
+type NetworkFailure struct {
+    RequestID     string     `json:"requestId"`
+    Method        string     `json:"method"`
+    URL           string     `json:"url"`
+    Status        int        `json:"status,omitempty"`
+    StatusText    string     `json:"statusText,omitempty"`
+    ResourceType  string     `json:"resourceType,omitempty"`
+    ErrorText     string     `json:"errorText,omitempty"`
+    Canceled      bool       `json:"canceled,omitempty"`
+    BlockedReason string     `json:"blockedReason,omitempty"`
+    Time          time.Time  `json:"time"`       // when the request was sent
+    Duration      float64    `json:"durationMs"` // until the response or failure
+    Initiator     *Initiator `json:"initiator,omitempty"`
+    Navigation    int        `json:"navigation"`
+}
+
+// Initiator describes what caused a network request
+type Initiator struct {
+    Type         string      `json:"type"` // parser, script, preload, other
+    URL          string      `json:"url,omitempty"`
+    LineNumber   int         `json:"lineNumber,omitempty"`
+    ColumnNumber int         `json:"columnNumber,omitempty"`
+    StackTrace   *StackTrace `json:"stackTrace,omitempty"`
 }
 
 // PageResults contains categorized messages for a single page
 type PageResults struct {
+    TargetID string   `json:"targetId"`
+    Endpoint string   `json:"endpoint"` // name of the browser endpoint the tab lives in
+    Type     string   `json:"type"`
+    URL      string   `json:"url"`
+    Title    string   `json:"title"`
+    Matched  []string `json:"matched"` // keys of the matchers that selected the target
+
     Console []ConsoleMessage `json:"console"`
     Errors  []ConsoleMessage `json:"errors"`
+    Network []NetworkFailure `json:"network"`
+    Issues  []ConsoleMessage `json:"issues"`
+
+    Navigations []Navigation `json:"navigations"` // main frame navigations seen while capturing
+
+    StopReason string `json:"stopReason,omitempty"` // which stop condition ended the capture
+    OpenedTab  bool   `json:"openedTab,omitempty"`  // the tab was opened for this capture
+
+    // Truncated is set when capture limits dropped or cut messages;
+    // TruncatedBy names the limits that did
+    Truncated       bool     `json:"truncated"`
+    TruncatedBy     []string `json:"truncatedBy,omitempty"`     // maxMessages, maxMessageBytes, maxTotalBytes, eventQueue
+    DroppedMessages int      `json:"droppedMessages,omitempty"` // messages not kept because of maxMessages or maxTotalBytes
+    DroppedEvents   int      `json:"droppedEvents,omitempty"`   // console and log events dropped while the capture fell behind
+}
+
+// Navigation is a main frame navigation observed during a capture. Index
+// starts at 1; messages from the document that was already loaded when
+// capture attached belong to navigation 0.
+type Navigation struct {
+    Index    int       `json:"index"`
+    URL      string    `json:"url"`
+    FrameID  string    `json:"frameId"`
+    LoaderID string    `json:"loaderId,omitempty"`
+    Time     time.Time `json:"time"`
+}
+
+// TargetMatcher selects debugging targets. Every field that is set must
+// match. Match and TitleMatch pick how URL and Title are compared:
+// contains (default), exact, prefix, glob or regex.
+type TargetMatcher struct {
+    URL        string   `json:"url,omitempty"`
+    Match      string   `json:"match,omitempty"`
+    Title      string   `json:"title,omitempty"`
+    TitleMatch string   `json:"titleMatch,omitempty"`
+    ID         string   `json:"id,omitempty"`
+    Types      []string `json:"types,omitempty"` // default ["page"] (any type for ID matchers), "*" for any
+
+    urlPattern   *regexp.Regexp
+    titlePattern *regexp.Regexp
 }
 
 // DebugRequest represents the incoming request to debug specific URLs
 type DebugRequest struct {
-    URLs []string `json:"urls"`
+    URLs    []string        `json:"urls"`    // shorthand for "contains" URL matchers
+    Targets []TargetMatcher `json:"targets,omitempty"`
+
+    // Endpoint picks the browser by configured name or as a URL such as
+    // "http://chrome:9222"; AllEndpoints captures from every configured one
+    Endpoint     string `json:"endpoint,omitempty"`
+    AllEndpoints bool   `json:"allEndpoints,omitempty"`
+
+    // Concurrency is how many targets are captured at the same time
+    // (defaults to the server's configured limit)
+    Concurrency int `json:"concurrency,omitempty"`
+
+    // ValueDepth and ValueProperties limit how far console arguments are
+    // expanded (default to the server's configuration)
+    ValueDepth      int `json:"valueDepth,omitempty"`
+    ValueProperties int `json:"valueProperties,omitempty"`
+
+    // Limits caps what is kept of each target, so a page flooding the
+    // console cannot exhaust memory (defaults to the server's configuration)
+    Limits CaptureLimits `json:"limits"`
+
+    // LegacyConsole enables the Console domain next to Runtime. Its reports
+    // are deduplicated against Runtime's; set to false to skip it entirely.
+    LegacyConsole *bool `json:"legacyConsole,omitempty"`
+
+    // Reload the target once capture is attached, so load time output is
+    // included. BypassCache reloads as with Shift+Refresh.
+    Reload      bool `json:"reload,omitempty"`
+    BypassCache bool `json:"bypassCache,omitempty"`
+
+    // NavigateURL navigates the target to this URL once capture is attached
+    NavigateURL string `json:"navigateUrl,omitempty"`
+
+    // OpenTabs opens a new tab for URLs without a matching target (default
+    // true). Such tabs are closed after capture unless KeepTabs is set.
+    OpenTabs *bool `json:"openTabs,omitempty"`
+    KeepTabs bool  `json:"keepTabs,omitempty"`
+
+    // Stop decides when each target's capture ends
+    Stop StopConditions `json:"stop"`
+}
+
+// WatchRequest starts a watch, which stays attached to every tab the
+// request matches until it is stopped. Capture options of the embedded
+// request apply; navigation and stop conditions do not.
+type WatchRequest struct {
+    DebugRequest
+
+    // BucketMs is the length of the time buckets messages are stored in
+    // (defaults to one minute)
+    BucketMs int `json:"bucketMs,omitempty"`
+}
+
+// CaptureLimits cap the messages kept of a target. Repeats of a kept
+// message are folded into it and still counted once the limits are hit.
+// Zero values fall back to the server's configuration.
+type CaptureLimits struct {
+    MaxMessages     int `json:"maxMessages,omitempty"`
+    MaxMessageBytes int `json:"maxMessageBytes,omitempty"` // per string: a message's text and each string in its arguments
+    MaxTotalBytes   int `json:"maxTotalBytes,omitempty"`
+}
+
+// StopConditions end a capture at whichever condition is met first. All
+// durations are in milliseconds and zero values are ignored; without a
+// MaxDurationMs a capture is capped at 30 seconds.
+type StopConditions struct {
+    MaxDurationMs int  `json:"maxDurationMs,omitempty"`
+    LoadQuietMs   int  `json:"loadQuietMs,omitempty"`   // after the load event and N ms without new activity
+    NetworkIdleMs int  `json:"networkIdleMs,omitempty"` // after N ms with no request in flight
+    FirstError    bool `json:"firstError,omitempty"`    // after the first error or exception
+    MaxMessages   int  `json:"maxMessages,omitempty"`   // after N messages
+}
+
+// StreamEvent is pushed to live stream subscribers as soon as a capture
+// records a message or a failed request. Source maps are not resolved yet.
+type StreamEvent struct {
+    Kind     string          `json:"kind"`     // "console" or "network"
+    TargetID string          `json:"targetId"`
+    URL      string          `json:"url"`      // the page being captured
+    Message  *ConsoleMessage `json:"message,omitempty"`
+    Network  *NetworkFailure `json:"network,omitempty"`
 }
 
 // DebugResponse represents the debugging results for multiple targets
 type DebugResponse struct {
-    Results map[string]PageResults `json:"results"` // URL -> results mapping
-    Errors  map[string]string      `json:"errors"`  // URL -> error message mapping
+    Results map[string]PageResults `json:"results"` // target ID -> results mapping
+    Errors  map[string]string      `json:"errors"`  // matcher key or target ID -> error message mapping
+
+    Browsers map[string]BrowserVersion `json:"browsers"` // endpoint name -> browser metadata
 }
\ No newline at end of file
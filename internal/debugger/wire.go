@@ -0,0 +1,214 @@
+package debugger
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"sync"
+)
+
+var errMalformed = errors.New("malformed CDP message")
+
+// maxPooledBuffer keeps the odd huge message (a big source map or script
+// source) from pinning its buffer in the pool
+const maxPooledBuffer = 1 << 20
+
+var readBuffers = sync.Pool{
+	New: func() interface{} {
+		return new(bytes.Buffer)
+	},
+}
+
+func getReadBuffer() *bytes.Buffer {
+	buf := readBuffers.Get().(*bytes.Buffer)
+	buf.Reset()
+	return buf
+}
+
+func putReadBuffer(buf *bytes.Buffer) {
+	if buf.Cap() <= maxPooledBuffer {
+		readBuffers.Put(buf)
+	}
+}
+
+// wireMessage holds the top-level fields of a CDP message. The byte slices
+// point into the message that was scanned and are only valid as long as it
+// is; params, result and error are left undecoded.
+type wireMessage struct {
+	id        int64
+	method    []byte
+	sessionID []byte
+	params    []byte
+	result    []byte
+	error     []byte
+}
+
+// scanMessage finds the top-level fields of a CDP message without decoding
+// the values it does not need, so events nobody subscribed to cost a single
+// pass over their bytes. Values are skipped, not validated; whatever is
+// decoded later is validated by encoding/json.
+func scanMessage(data []byte) (wireMessage, error) {
+	var msg wireMessage
+
+	i := skipSpace(data, 0)
+	if i == len(data) || data[i] != '{' {
+		return msg, errMalformed
+	}
+	i = skipSpace(data, i+1)
+	if i < len(data) && data[i] == '}' {
+		return msg, nil
+	}
+
+	for {
+		if i == len(data) || data[i] != '"' {
+			return msg, errMalformed
+		}
+		end, ok := skipString(data, i)
+		if !ok {
+			return msg, errMalformed
+		}
+		key := data[i+1 : end-1]
+
+		i = skipSpace(data, end)
+		if i == len(data) || data[i] != ':' {
+			return msg, errMalformed
+		}
+		start := skipSpace(data, i+1)
+		end, ok = skipValue(data, start)
+		if !ok {
+			return msg, errMalformed
+		}
+		value := data[start:end]
+
+		switch string(key) {
+		case "id":
+			if msg.id, ok = parseID(value); !ok {
+				return msg, errMalformed
+			}
+		case "method":
+			if msg.method, ok = stringValue(value); !ok {
+				return msg, errMalformed
+			}
+		case "sessionId":
+			if msg.sessionID, ok = stringValue(value); !ok {
+				return msg, errMalformed
+			}
+		case "params":
+			msg.params = value
+		case "result":
+			msg.result = value
+		case "error":
+			msg.error = value
+		}
+
+		i = skipSpace(data, end)
+		if i == len(data) {
+			return msg, errMalformed
+		}
+		switch data[i] {
+		case ',':
+			i = skipSpace(data, i+1)
+		case '}':
+			return msg, nil
+		default:
+			return msg, errMalformed
+		}
+	}
+}
+
+func skipSpace(data []byte, i int) int {
+	for i < len(data) {
+		switch data[i] {
+		case ' ', '\t', '\n', '\r':
+			i++
+		default:
+			return i
+		}
+	}
+	return i
+}
+
+// skipString returns the index just past the string starting at data[i]
+func skipString(data []byte, i int) (int, bool) {
+	for i++; i < len(data); i++ {
+		switch data[i] {
+		case '\\':
+			i++
+		case '"':
+			return i + 1, true
+		}
+	}
+	return 0, false
+}
+
+// skipValue returns the index just past the value starting at data[i]
+func skipValue(data []byte, i int) (int, bool) {
+	if i == len(data) {
+		return 0, false
+	}
+	switch data[i] {
+	case '"':
+		return skipString(data, i)
+	case '{', '[':
+		depth := 0
+		for ; i < len(data); i++ {
+			switch data[i] {
+			case '"':
+				end, ok := skipString(data, i)
+				if !ok {
+					return 0, false
+				}
+				i = end - 1
+			case '{', '[':
+				depth++
+			case '}', ']':
+				depth--
+				if depth == 0 {
+					return i + 1, true
+				}
+			}
+		}
+		return 0, false
+	}
+	// Numbers, true, false and null run up to the next delimiter
+	start := i
+	for i < len(data) {
+		switch data[i] {
+		case ',', '}', ']', ' ', '\t', '\n', '\r':
+			return i, i > start
+		}
+		i++
+	}
+	return i, i > start
+}
+
+// parseID reads a command ID, a non-negative integer
+func parseID(value []byte) (int64, bool) {
+	if len(value) == 0 {
+		return 0, false
+	}
+	var id int64
+	for _, c := range value {
+		if c < '0' || c > '9' {
+			return 0, false
+		}
+		id = id*10 + int64(c-'0')
+	}
+	return id, true
+}
+
+// stringValue returns the contents of a JSON string. Only strings with
+// escapes, which method names and session IDs never have, are copied.
+func stringValue(value []byte) ([]byte, bool) {
+	if len(value) < 2 || value[0] != '"' {
+		return nil, false
+	}
+	if bytes.IndexByte(value, '\\') < 0 {
+		return value[1 : len(value)-1], true
+	}
+	var s string
+	if err := json.Unmarshal(value, &s); err != nil {
+		return nil, false
+	}
+	return []byte(s), true
+}
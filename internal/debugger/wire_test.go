@@ -0,0 +1,92 @@
+package debugger
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+)
+
+// jsonMessage is a CDP message decoded by encoding/json, the reference
+// scanMessage is checked against
+type jsonMessage struct {
+	ID        int64           `json:"id"`
+	Method    string          `json:"method"`
+	SessionID string          `json:"sessionId"`
+	Params    json.RawMessage `json:"params"`
+	Result    json.RawMessage `json:"result"`
+	Error     json.RawMessage `json:"error"`
+}
+
+func checkScan(t *testing.T, data []byte) {
+	t.Helper()
+
+	var want jsonMessage
+	if err := json.Unmarshal(data, &want); err != nil {
+		t.Fatalf("encoding/json rejected %s: %v", data, err)
+	}
+	got, err := scanMessage(data)
+	if err != nil {
+		t.Errorf("scanMessage(%s): %v", data, err)
+		return
+	}
+	if got.id != want.ID || string(got.method) != want.Method || string(got.sessionID) != want.SessionID ||
+		!bytes.Equal(got.params, want.Params) || !bytes.Equal(got.result, want.Result) || !bytes.Equal(got.error, want.Error) {
+		t.Errorf("scanMessage(%s) = id %d method %q session %q params %s result %s error %s, encoding/json found %+v",
+			data, got.id, got.method, got.sessionID, got.params, got.result, got.error, want)
+	}
+}
+
+func TestScanMessage(t *testing.T) {
+	for _, data := range []string{
+		`{}`,
+		` { } `,
+		`{"id":1,"result":{}}`,
+		`{"id":42,"result":{"result":{"type":"number","value":1.5e+3}},"sessionId":"AB12"}`,
+		`{"id":7,"error":{"code":-32601,"message":"'Foo.bar' wasn't found"}}`,
+		`{"method":"Runtime.consoleAPICalled","params":{"type":"log","args":[{"type":"string","value":"}]{[\""}]}}`,
+		`{"method":"Page.loadEventFired","params":{"timestamp":12.5},"sessionId":"S1"}`,
+		"{\n  \"method\" : \"Log.entryAdded\" ,\n  \"params\" : {\"entry\": {\"text\": \"a\\\\b\"}}\n}",
+		`{"method":"Inspector.detached","params":{"reason":"target_closed"},"extra":[1,[2,{"3":null}]],"flag":true}`,
+		`{"method":"Weird.event","params":null}`,
+		`{"params":{"nested":{"method":"Not.me","id":99}},"method":"Network.loadingFinished"}`,
+	} {
+		checkScan(t, []byte(data))
+	}
+}
+
+func TestScanMessageRejects(t *testing.T) {
+	for _, data := range []string{
+		``,
+		`not json`,
+		`[]`,
+		`{"id":1`,
+		`{"id":1,}`,
+		`{"id" 1}`,
+		`{"id":"1"}`,
+		`{"method":1}`,
+		`{"params":{"text":"unterminated}`,
+		`{"params":[1,2}`,
+	} {
+		if msg, err := scanMessage([]byte(data)); err == nil {
+			t.Errorf("scanMessage(%s) = %+v, want an error", data, msg)
+		}
+		var ref jsonMessage
+		if err := json.Unmarshal([]byte(data), &ref); err == nil {
+			t.Errorf("encoding/json accepted %s", data)
+		}
+	}
+}
+
+func TestScanMessageRejectsNegativeID(t *testing.T) {
+	// encoding/json takes it, but command IDs are never negative
+	if _, err := scanMessage([]byte(`{"id":-1}`)); err == nil {
+		t.Error("scanMessage accepted a negative ID")
+	}
+}
+
+func TestScanTrace(t *testing.T) {
+	messages, _ := loadTrace(t)
+	for _, data := range messages {
+		checkScan(t, data)
+	}
+}
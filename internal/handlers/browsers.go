@@ -0,0 +1,39 @@
+package handlers
+
+import (
+	"context"
+
+	"debugger-api/internal/debugger"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// GetBrowsers lists the configured Chrome endpoints with the metadata each
+// one reports at /json/version
+func GetBrowsers(c *fiber.Ctx) error {
+	browsers := make([]fiber.Map, 0, len(cfg.Endpoints))
+	for _, endpoint := range cfg.Endpoints {
+		entry := fiber.Map{
+			"name": endpoint.Name,
+			"url":  endpoint.URL,
+		}
+		version, err := endpointVersion(c.UserContext(), endpoint.URL)
+		if err != nil {
+			entry["error"] = err.Error()
+		} else {
+			entry["version"] = version
+		}
+		browsers = append(browsers, entry)
+	}
+
+	return c.JSON(fiber.Map{"browsers": browsers})
+}
+
+func endpointVersion(ctx context.Context, url string) (*debugger.BrowserVersion, error) {
+	browser, err := debugger.ConnectBrowser(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+	defer browser.Close()
+	return browser.Version(ctx)
+}
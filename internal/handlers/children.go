@@ -0,0 +1,94 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"debugger-api/internal/debugger"
+	"debugger-api/internal/protocol"
+)
+
+// autoAttachParams makes Chrome attach to workers, service workers and
+// out-of-process iframes over flattened sessions on the same websocket.
+// They start paused so nothing they log before we enable Runtime is lost.
+var autoAttachParams = protocol.TargetSetAutoAttachParams{
+	AutoAttach:             true,
+	WaitForDebuggerOnStart: true,
+	Flatten:                true,
+}
+
+// attachedToTarget prepares a new child session and lets it run
+func (tc *targetCapture) attachedToTarget(ctx context.Context, parentSession string, params *protocol.TargetAttachedToTargetEvent) {
+	sessionID := params.SessionID
+	if sessionID == "" {
+		return
+	}
+
+	child := &debugger.TargetInfo{
+		ID:   params.TargetInfo.TargetID,
+		Type: params.TargetInfo.Type,
+		URL:  params.TargetInfo.URL,
+	}
+	tc.children[sessionID] = child
+	fmt.Printf("🔗 Attached to %s %s\n", child.Type, child.URL)
+
+	session := tc.client.Session(sessionID)
+	commands := []struct {
+		method string
+		params interface{}
+	}{
+		{protocol.CommandRuntimeEnable, nil},
+		{protocol.CommandLogEnable, nil},
+		// Children can have children of their own (workers in iframes)
+		{protocol.CommandTargetSetAutoAttach, autoAttachParams},
+	}
+	for _, command := range commands {
+		// Not every target type supports every domain
+		if err := session.Call(ctx, command.method, command.params, nil); err != nil {
+			fmt.Printf("⚠️ %s on %s %s: %v\n", command.method, child.Type, child.URL, err)
+		}
+	}
+
+	if params.WaitingForDebugger {
+		if err := session.Call(ctx, protocol.CommandRuntimeRunIfWaitingForDebugger, nil, nil); err != nil {
+			fmt.Printf("❌ Failed to resume %s %s: %v\n", child.Type, child.URL, err)
+		}
+	}
+}
+
+func (tc *targetCapture) detachedFromTarget(params *protocol.TargetDetachedFromTargetEvent) {
+	delete(tc.children, params.SessionID)
+}
+
+// handleChildEvent records console output and exceptions from a child
+// target, tagged with the child's type and URL
+func (tc *targetCapture) handleChildEvent(ctx context.Context, sessionID string, params interface{}, at time.Time) {
+	origin, ok := tc.children[sessionID]
+	if !ok {
+		return
+	}
+
+	var msg debugger.ConsoleMessage
+	switch params := params.(type) {
+	case *protocol.RuntimeConsoleAPICalledEvent:
+		msg, expand := parseConsoleCall(ctx, tc.client.Session(sessionID), params, tc.values)
+		tc.groups.assign(&msg, sessionID)
+		msg.Target = origin
+		key, foldable := runtimeRepeatKey(&msg, params)
+		tc.keep(msg, key, foldable, expand, at)
+		return
+	case *protocol.RuntimeExceptionThrownEvent:
+		msg = parseException(params)
+	case *protocol.RuntimeExceptionRevokedEvent:
+		revokeException(tc.messages, params, origin)
+		return
+	case *protocol.LogEntryAddedEvent:
+		msg = parseLogEntry(params)
+	default:
+		return
+	}
+
+	msg.Target = origin
+	tc.add(msg, at)
+}
@@ -0,0 +1,157 @@
+package handlers
+
+import (
+	"regexp"
+	"strconv"
+
+	"debugger-api/internal/debugger"
+)
+
+// consoleLevel maps a console API type to the level its message is shown
+// at. Failed assertions are errors.
+func consoleLevel(apiType string) string {
+	switch apiType {
+	case "error", "assert":
+		return "error"
+	case "warning", "info", "debug":
+		return apiType
+	}
+	return "log"
+}
+
+// applyConsoleSemantics fills in what a console API type means beyond its
+// formatted text
+func applyConsoleSemantics(msg *debugger.ConsoleMessage) {
+	switch msg.APIType {
+	case "assert":
+		if msg.Message == "" {
+			msg.Message = "Assertion failed"
+		} else {
+			msg.Message = "Assertion failed: " + msg.Message
+		}
+	case "table":
+		msg.Table = parseConsoleTable(msg.Args)
+	case "timeEnd", "timeLog":
+		msg.Timer = parseConsoleTimer(msg.Args)
+	case "trace":
+		if msg.Message == "" {
+			msg.Message = "console.trace"
+		}
+	}
+}
+
+// groupTracker follows console.group nesting per session, so workers and
+// iframes keep their own groups
+type groupTracker struct {
+	last int
+	open map[string][]int // session ID -> IDs of the open groups
+}
+
+// assign sets the group fields of a console API message, opening or
+// closing a group as its type asks
+func (g *groupTracker) assign(msg *debugger.ConsoleMessage, session string) {
+	if g.open == nil {
+		g.open = make(map[string][]int)
+	}
+	stack := g.open[session]
+
+	msg.GroupDepth = len(stack)
+	if len(stack) > 0 {
+		msg.ParentGroup = stack[len(stack)-1]
+	}
+
+	switch msg.APIType {
+	case "startGroup", "startGroupCollapsed":
+		g.last++
+		msg.GroupID = g.last
+		g.open[session] = append(stack, g.last)
+	case "endGroup":
+		// endGroup is listed inside the group it closes
+		if len(stack) > 0 {
+			g.open[session] = stack[:len(stack)-1]
+		}
+	}
+}
+
+// parseConsoleTable reads the rows of console.table(data, columns). Each
+// array item or object property is a row; object rows spread their
+// properties over columns. The optional second argument picks the columns.
+func parseConsoleTable(args []*debugger.RemoteValue) *debugger.ConsoleTable {
+	if len(args) == 0 || args[0].Type != "object" {
+		return nil
+	}
+	data := args[0]
+
+	type row struct {
+		index string
+		value *debugger.RemoteValue
+	}
+	var rows []row
+	for i, item := range data.Items {
+		rows = append(rows, row{strconv.Itoa(i), item})
+	}
+	for _, prop := range data.Properties {
+		rows = append(rows, row{prop.Name, prop.Value})
+	}
+	if len(rows) == 0 {
+		return nil
+	}
+
+	table := &debugger.ConsoleTable{Columns: make([]string, 0), Rows: make([]debugger.TableRow, 0, len(rows))}
+	seen := make(map[string]bool)
+	addColumn := func(name string) {
+		if !seen[name] {
+			seen[name] = true
+			table.Columns = append(table.Columns, name)
+		}
+	}
+
+	for _, r := range rows {
+		values := make(map[string]*debugger.RemoteValue)
+		switch {
+		case r.value.Properties != nil:
+			for _, prop := range r.value.Properties {
+				values[prop.Name] = prop.Value
+				addColumn(prop.Name)
+			}
+		case r.value.Items != nil:
+			for i, item := range r.value.Items {
+				name := strconv.Itoa(i)
+				values[name] = item
+				addColumn(name)
+			}
+		default:
+			values["Value"] = r.value
+			addColumn("Value")
+		}
+		table.Rows = append(table.Rows, debugger.TableRow{Index: r.index, Values: values})
+	}
+
+	if len(args) > 1 && args[1].Items != nil {
+		columns := make([]string, 0, len(args[1].Items))
+		for _, item := range args[1].Items {
+			if name, ok := item.Value.(string); ok {
+				columns = append(columns, name)
+			}
+		}
+		table.Columns = columns
+	}
+	return table
+}
+
+var timerPattern = regexp.MustCompile(`^(.*): (\d+(?:\.\d+)?) ?ms`)
+
+// parseConsoleTimer reads the "label: 1.23 ms" text V8 reports for
+// console.timeEnd and console.timeLog
+func parseConsoleTimer(args []*debugger.RemoteValue) *debugger.ConsoleTimer {
+	if len(args) == 0 {
+		return nil
+	}
+	text, _ := args[0].Value.(string)
+	match := timerPattern.FindStringSubmatch(text)
+	if match == nil {
+		return nil
+	}
+	duration, _ := strconv.ParseFloat(match[2], 64)
+	return &debugger.ConsoleTimer{Label: match[1], DurationMs: duration}
+}
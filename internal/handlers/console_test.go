@@ -0,0 +1,144 @@
+package handlers
+
+import (
+	"reflect"
+	"testing"
+
+	"debugger-api/internal/debugger"
+)
+
+func TestGroupTrackerAssign(t *testing.T) {
+	// "" is the page; "w" a worker with groups of its own
+	tests := []struct {
+		session string
+		apiType string
+		group   int
+		depth   int
+		parent  int
+	}{
+		{"", "startGroup", 1, 0, 0},
+		{"", "log", 0, 1, 1},
+		{"w", "log", 0, 0, 0},
+		{"w", "startGroupCollapsed", 2, 0, 0},
+		{"", "startGroup", 3, 1, 1},
+		{"w", "log", 0, 1, 2},
+		{"", "endGroup", 0, 2, 3},
+		{"", "log", 0, 1, 1},
+		{"", "endGroup", 0, 1, 1},
+		// An endGroup without an open group changes nothing
+		{"", "endGroup", 0, 0, 0},
+		{"", "log", 0, 0, 0},
+		{"w", "endGroup", 0, 1, 2},
+		{"w", "log", 0, 0, 0},
+	}
+
+	var groups groupTracker
+	for i, tt := range tests {
+		msg := debugger.ConsoleMessage{APIType: tt.apiType}
+		groups.assign(&msg, tt.session)
+		if msg.GroupID != tt.group || msg.GroupDepth != tt.depth || msg.ParentGroup != tt.parent {
+			t.Errorf("%d: %s in %q = group %d, depth %d, parent %d, want %d, %d, %d",
+				i, tt.apiType, tt.session, msg.GroupID, msg.GroupDepth, msg.ParentGroup, tt.group, tt.depth, tt.parent)
+		}
+	}
+}
+
+func numberValue(n float64) *debugger.RemoteValue {
+	return &debugger.RemoteValue{Type: "number", Value: n, Description: jsNumber(n)}
+}
+
+func objectValue(props ...debugger.RemoteProperty) *debugger.RemoteValue {
+	return &debugger.RemoteValue{Type: "object", ClassName: "Object", Description: "Object", Properties: props}
+}
+
+func arrayValue(items ...*debugger.RemoteValue) *debugger.RemoteValue {
+	return &debugger.RemoteValue{Type: "object", Subtype: "array", ClassName: "Array", Items: items, Size: len(items)}
+}
+
+func TestParseConsoleTable(t *testing.T) {
+	prop := func(name string, v *debugger.RemoteValue) debugger.RemoteProperty {
+		return debugger.RemoteProperty{Name: name, Value: v}
+	}
+	users := func() *debugger.RemoteValue {
+		return arrayValue(
+			objectValue(prop("name", stringValue("ada")), prop("age", numberValue(36))),
+			objectValue(prop("name", stringValue("alan")), prop("role", stringValue("admin"))),
+		)
+	}
+	userRows := []debugger.TableRow{
+		{Index: "0", Values: map[string]*debugger.RemoteValue{"name": stringValue("ada"), "age": numberValue(36)}},
+		{Index: "1", Values: map[string]*debugger.RemoteValue{"name": stringValue("alan"), "role": stringValue("admin")}},
+	}
+
+	tests := []struct {
+		name string
+		args []*debugger.RemoteValue
+		want *debugger.ConsoleTable
+	}{
+		{
+			name: "array of objects",
+			args: []*debugger.RemoteValue{users()},
+			want: &debugger.ConsoleTable{Columns: []string{"name", "age", "role"}, Rows: userRows},
+		},
+		{
+			name: "columns picked by the second argument",
+			args: []*debugger.RemoteValue{users(), arrayValue(stringValue("role"), stringValue("name"))},
+			want: &debugger.ConsoleTable{Columns: []string{"role", "name"}, Rows: userRows},
+		},
+		{
+			name: "object of values",
+			args: []*debugger.RemoteValue{objectValue(prop("x", numberValue(1)), prop("y", stringValue("two")))},
+			want: &debugger.ConsoleTable{Columns: []string{"Value"}, Rows: []debugger.TableRow{
+				{Index: "x", Values: map[string]*debugger.RemoteValue{"Value": numberValue(1)}},
+				{Index: "y", Values: map[string]*debugger.RemoteValue{"Value": stringValue("two")}},
+			}},
+		},
+		{
+			name: "array of arrays",
+			args: []*debugger.RemoteValue{arrayValue(arrayValue(numberValue(1), numberValue(2)), arrayValue(numberValue(3)))},
+			want: &debugger.ConsoleTable{Columns: []string{"0", "1"}, Rows: []debugger.TableRow{
+				{Index: "0", Values: map[string]*debugger.RemoteValue{"0": numberValue(1), "1": numberValue(2)}},
+				{Index: "1", Values: map[string]*debugger.RemoteValue{"0": numberValue(3)}},
+			}},
+		},
+		{
+			name: "empty array",
+			args: []*debugger.RemoteValue{arrayValue()},
+		},
+		{
+			name: "not an object",
+			args: []*debugger.RemoteValue{stringValue("rows")},
+		},
+		{
+			name: "no arguments",
+		},
+	}
+
+	for _, tt := range tests {
+		if got := parseConsoleTable(tt.args); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: parseConsoleTable = %+v, want %+v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestParseConsoleTimer(t *testing.T) {
+	tests := []struct {
+		args []*debugger.RemoteValue
+		want *debugger.ConsoleTimer
+	}{
+		{[]*debugger.RemoteValue{stringValue("default: 1.234 ms")}, &debugger.ConsoleTimer{Label: "default", DurationMs: 1.234}},
+		{[]*debugger.RemoteValue{stringValue("render: 12ms")}, &debugger.ConsoleTimer{Label: "render", DurationMs: 12}},
+		// The label may hold ": " itself
+		{[]*debugger.RemoteValue{stringValue("fetch: users: 250.5 ms")}, &debugger.ConsoleTimer{Label: "fetch: users", DurationMs: 250.5}},
+		// console.timeLog passes its extra data as more arguments
+		{[]*debugger.RemoteValue{stringValue("load: 3 ms"), stringValue("step 2")}, &debugger.ConsoleTimer{Label: "load", DurationMs: 3}},
+		{[]*debugger.RemoteValue{stringValue("Timer 'x' does not exist")}, nil},
+		{[]*debugger.RemoteValue{numberValue(5)}, nil},
+		{nil, nil},
+	}
+	for _, tt := range tests {
+		if got := parseConsoleTimer(tt.args); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("parseConsoleTimer(%v) = %+v, want %+v", tt.args, got, tt.want)
+		}
+	}
+}
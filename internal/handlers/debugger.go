@@ -1,33 +1,58 @@
 package handlers
 
 import (
-	"encoding/json"
+	"context"
 	"fmt"
 	"strings"
 	"sync"
-	"sync/atomic"
 	"time"
 
+	"debugger-api/internal/config"
 	"debugger-api/internal/debugger"
+	"debugger-api/internal/protocol"
+	"debugger-api/internal/sourcemap"
 	"debugger-api/internal/storage"
 
 	"github.com/gofiber/fiber/v2"
-	"github.com/gorilla/websocket"
 )
 
 var store *storage.Store
+var cfg = config.Load()
 var messagePool = sync.Pool{
 	New: func() interface{} {
-		return make([]debugger.ConsoleMessage, 0, 100)
+		messages := make([]debugger.ConsoleMessage, 0, 100)
+		return &messages
 	},
 }
 
+// maxPooledMessages keeps the slice of a flooded capture out of the pool
+const maxPooledMessages = 4096
+
+func getMessages() []debugger.ConsoleMessage {
+	return (*messagePool.Get().(*[]debugger.ConsoleMessage))[:0]
+}
+
+// putMessages returns a capture's slice once its results were built. The
+// messages are cleared so the pool does not keep their values alive.
+func putMessages(messages []debugger.ConsoleMessage) {
+	if cap(messages) > maxPooledMessages {
+		return
+	}
+	clear(messages[:cap(messages)])
+	messages = messages[:0]
+	messagePool.Put(&messages)
+}
+
 func init() {
 	var err error
 	store, err = storage.NewStore("./data")
 	if err != nil {
 		panic(fmt.Sprintf("Failed to initialize storage: %v", err))
 	}
+	jobs, err = storage.NewJobStore("./data")
+	if err != nil {
+		panic(fmt.Sprintf("Failed to initialize job storage: %v", err))
+	}
 }
 
 func HandleDebugger(c *fiber.Ctx) error {
@@ -45,395 +70,631 @@ func HandleDebugger(c *fiber.Ctx) error {
 		return c.Status(400).JSON(fiber.Map{"error": "Invalid request"})
 	}
 
-	fmt.Printf("📍 Debugging URLs: %v\n", req.URLs)
+	session, err := newDebugSession(&req)
+	if err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": err.Error()})
+	}
 
-	chrome := debugger.NewChromeDebugger()
-	targets, err := chrome.GetDebuggingTargets(req.URLs)
+	response, err := session.run(c.UserContext(), nil)
 	if err != nil {
-		fmt.Printf("❌ Failed to get targets: %v\n", err)
-		return c.Status(500).JSON(fiber.Map{"error": err.Error()})
+		return c.Status(500).JSON(fiber.Map{"error": err.Error(), "errors": response.Errors})
 	}
+	return c.JSON(response)
+}
 
-	fmt.Printf("✅ Found %d targets\n", len(targets))
+// debugSession is a validated capture request, ready to run either inline
+// or as a background job
+type debugSession struct {
+	req       *debugger.DebugRequest
+	matchers  []debugger.TargetMatcher
+	endpoints []*browserEndpoint
+}
+
+// newDebugSession validates a request's matchers and endpoints
+func newDebugSession(req *debugger.DebugRequest) (*debugSession, error) {
+	matchers, err := req.Matchers()
+	if err != nil {
+		fmt.Printf("❌ Invalid target matcher: %v\n", err)
+		return nil, err
+	}
 
+	keys := make([]string, len(matchers))
+	for i := range matchers {
+		keys[i] = matchers[i].Key()
+	}
+	fmt.Printf("📍 Debugging targets: %v\n", keys)
+
+	endpoints, err := requestEndpoints(req)
+	if err != nil {
+		return nil, err
+	}
+	return &debugSession{req: req, matchers: matchers, endpoints: endpoints}, nil
+}
+
+// run selects and captures the targets and saves the results. The
+// response is returned even on error so per-endpoint failures are kept.
+func (s *debugSession) run(ctx context.Context, progress progressFunc) (debugger.DebugResponse, error) {
 	response := debugger.DebugResponse{
-		Results: make(map[string]debugger.PageResults),
-		Errors:  make(map[string]string),
+		Results:  make(map[string]debugger.PageResults),
+		Errors:   make(map[string]string),
+		Browsers: make(map[string]debugger.BrowserVersion),
 	}
 
-	for url, target := range targets {
-		fmt.Printf("📍 Debugging target: %s\n", url)
-		logs, err := debugTarget(target)
-		if err != nil {
-			fmt.Printf("❌ Error debugging %s: %v\n", url, err)
-			response.Errors[url] = err.Error()
-			continue
+	defer closeEndpoints(s.endpoints)
+	targets, err := selectTargets(ctx, s.req, s.matchers, s.endpoints, &response)
+	if err != nil {
+		fmt.Printf("❌ Failed to get targets: %v\n", err)
+		return response, err
+	}
+
+	fmt.Printf("✅ Found %d targets\n", len(targets))
+
+	captureTargets(ctx, s.req, targets, &response, progress)
+
+	// Sessions are stored under the matcher that selected the tabs, so they
+	// can be looked up with the same URL that was asked for
+	sessions := make(map[string]map[string]debugger.PageResults)
+	for id, results := range response.Results {
+		for _, key := range results.Matched {
+			if sessions[key] == nil {
+				sessions[key] = make(map[string]debugger.PageResults)
+			}
+			sessions[key][id] = results
 		}
-		
-		results := categorizeMessages(logs)
-		response.Results[url] = results
-		fmt.Printf("✅ Collected %d console, %d errors messages\n", 
-			len(results.Console), len(results.Errors))
 	}
 
-	for url, results := range response.Results {
-		if err := store.SaveSession(url, results, response.Errors); err != nil {
-			fmt.Printf("❌ Failed to save session for %s: %v\n", url, err)
-			return c.Status(500).JSON(fiber.Map{"error": "Failed to save"})
+	for key, results := range sessions {
+		if err := store.SaveSession(key, results, response.Errors); err != nil {
+			fmt.Printf("❌ Failed to save session for %s: %v\n", key, err)
+			return response, fmt.Errorf("Failed to save")
 		}
 	}
 
 	fmt.Println("✅ Debug session completed")
-	return c.JSON(response)
+	return response, nil
 }
 
-func enableDebugging(ws *websocket.Conn) error {
-	enableRuntime := map[string]interface{}{
-		"id":     1,
-		"method": "Runtime.enable",
-		"params": map[string]interface{}{
-			"notifyOnConsoleAPICalled": true,
-		},
-	}
+// captureTargets captures every selected target, at most Concurrency at a
+// time, and fills in response. Failures are reported per target ID.
+func captureTargets(ctx context.Context, req *debugger.DebugRequest, targets map[string]*selectedTarget, response *debugger.DebugResponse, progress progressFunc) {
+	var (
+		wg  sync.WaitGroup
+		mu  sync.Mutex
+		sem = make(chan struct{}, captureConcurrency(req))
+	)
+
+	for id, selected := range targets {
+		progress.report(selected.target, targetQueued, nil)
+
+		wg.Add(1)
+		go func(id string, selected *selectedTarget) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			target := selected.target
+			fmt.Printf("📍 Debugging target: %s (%s on %s)\n", target.URL, id, selected.endpoint.name)
+			progress.report(target, targetConnecting, nil)
+			results, err := debugTarget(ctx, selected.endpoint.browser, target, req, progress)
+			if target.OpenURL != "" && !req.KeepTabs {
+				// The tab is closed even when the request was cancelled
+				if err := selected.endpoint.browser.CloseTarget(context.Background(), target.ID); err != nil {
+					fmt.Printf("⚠️ Failed to close tab %s: %v\n", id, err)
+				}
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				fmt.Printf("❌ Error debugging %s: %v\n", id, err)
+				response.Errors[id] = err.Error()
+				progress.report(target, targetFailed, err)
+				return
+			}
 
-	enableConsole := map[string]interface{}{
-		"id":     2,
-		"method": "Console.enable",
+			results.Endpoint = selected.endpoint.name
+			results.Matched = selected.matched
+			response.Results[id] = results
+			fmt.Printf("✅ %s: collected %d console, %d errors messages, %d failed requests, %d issues\n",
+				id, len(results.Console), len(results.Errors), len(results.Network), len(results.Issues))
+			progress.report(target, targetDone, nil)
+		}(id, selected)
 	}
 
-	enableProperties := map[string]interface{}{
-		"id":     3,
-		"method": "Runtime.setCustomObjectFormatterEnabled",
-		"params": map[string]interface{}{
-			"enabled": true,
-		},
+	wg.Wait()
+}
+
+// captureConcurrency is how many targets are captured at once
+func captureConcurrency(req *debugger.DebugRequest) int {
+	if req.Concurrency > 0 {
+		return req.Concurrency
 	}
+	return cfg.CaptureConcurrency
+}
 
-	for _, command := range []map[string]interface{}{enableRuntime, enableConsole, enableProperties} {
-		if err := ws.WriteJSON(command); err != nil {
-			return fmt.Errorf("failed to enable debugging feature: %v", err)
+// pageTypes are the target types that have every domain a capture enables
+var pageTypes = map[string]bool{"page": true, "iframe": true, "webview": true}
+
+func enableDebugging(ctx context.Context, client debugger.Caller, targetType string, req *debugger.DebugRequest) error {
+	type command struct {
+		method   string
+		params   interface{}
+		pageOnly bool // not available on workers and other non-page targets
+	}
+
+	commands := []command{
+		{protocol.CommandRuntimeEnable, nil, false},
+		{protocol.CommandInspectorEnable, nil, true},
+	}
+	if useLegacyConsole(req) {
+		commands = append(commands, command{protocol.CommandConsoleEnable, nil, false})
+	}
+	commands = append(commands, []command{
+		{protocol.CommandRuntimeSetCustomObjectFormatterEnabled, protocol.RuntimeSetCustomObjectFormatterEnabledParams{Enabled: true}, false},
+		// Debugger is only needed for Debugger.scriptParsed (source maps),
+		// so make sure it never pauses the page
+		{protocol.CommandDebuggerEnable, nil, false},
+		{protocol.CommandDebuggerSetSkipAllPauses, protocol.DebuggerSetSkipAllPausesParams{Skip: true}, false},
+		{protocol.CommandNetworkEnable, nil, false},
+		{protocol.CommandLogEnable, nil, false},
+		{protocol.CommandAuditsEnable, nil, true},
+		{protocol.CommandPageEnable, nil, true},
+		{protocol.CommandTargetSetAutoAttach, autoAttachParams, false},
+	}...)
+
+	for _, command := range commands {
+		err := client.Call(ctx, command.method, command.params, nil)
+		if err == nil {
+			continue
 		}
-		_, _, err := ws.ReadMessage()
-		if err != nil {
-			return fmt.Errorf("failed to read response: %v", err)
+		// Workers and other targets are captured without the page domains
+		if command.pageOnly && !pageTypes[targetType] {
+			fmt.Printf("⚠️ %s on %s target: %v\n", command.method, targetType, err)
+			continue
 		}
+		return fmt.Errorf("failed to enable debugging feature %s: %v", command.method, err)
 	}
 	return nil
 }
 
-func captureDebugMessages(ws *websocket.Conn) []debugger.ConsoleMessage {
-	messages := messagePool.Get().([]debugger.ConsoleMessage)
-	timeout := time.After(30 * time.Second)
-	messageChannel := make(chan []byte, 50)
+// targetCapture holds the state collected while debugging a single target
+type targetCapture struct {
+	target   *debugger.DebuggingTarget
+	conn     *debugger.TargetConn
+	events   *debugger.Subscription
+	client   *debugger.CDPClient // the websocket events arrive on
+	page     debugger.Caller
+	session  string // the page's session ID on client, "" for its own websocket
+	resolver *sourcemap.Resolver
+	network  *networkTracker
+	dedupe   consoleDeduper
+	stop     stopState
+	children map[string]*debugger.TargetInfo // session ID -> auto-attached child
+	values   valueLimits
+	groups   groupTracker
+	flood    floodGuard
+	messages []debugger.ConsoleMessage
+
+	navigations []debugger.Navigation
+
+	// ended is set when the target went away (detached, closed or crashed)
+	ended string
+}
 
-	go func() {
-		for {
-			_, message, err := ws.ReadMessage()
-			if err != nil {
-				close(messageChannel)
-				return
-			}
-			messageChannel <- message
-		}
-	}()
+// captureDebugMessages handles events until a stop condition is met and
+// returns the reason the capture ended
+func captureDebugMessages(ctx context.Context, capture *targetCapture, events *debugger.Subscription, stop debugger.StopConditions) string {
+	timeout := time.After(maxDuration(stop))
+	ticker := time.NewTicker(stopCheckInterval)
+	defer ticker.Stop()
 
 	for {
 		select {
-		case message, ok := <-messageChannel:
+		case event, ok := <-events.C:
 			if !ok {
-				return messages
+				return stopDisconnected
 			}
-
-			var data map[string]interface{}
-			if err := json.Unmarshal(message, &data); err != nil {
-				continue
+			capture.handleEvent(ctx, event)
+			if capture.ended != "" {
+				return capture.ended
 			}
 
-			handleWebSocketMessage(data)
-
-			if method, ok := data["method"].(string); ok {
-				switch method {
-				case "Console.messageAdded":
-					msg := parseConsoleMessage(data)
-					messages = append(messages, msg)
-				case "Runtime.consoleAPICalled":
-					msg := parseRuntimeConsole(ws, data)
-					messages = append(messages, msg)
-				}
+			if reason := capture.checkStop(stop, time.Now()); reason != "" {
+				return reason
 			}
 
+		case now := <-ticker.C:
+			if reason := capture.checkStop(stop, now); reason != "" {
+				return reason
+			}
 		case <-timeout:
-			return messages
+			return stopMaxDuration
+		case <-ctx.Done():
+			return stopCancelled
 		}
 	}
 }
 
-func debugTarget(target *debugger.DebuggingTarget) ([]debugger.ConsoleMessage, error) {
-	ws, _, err := websocket.DefaultDialer.Dial(target.WebSocketDebuggerUrl, nil)
+func (tc *targetCapture) handleEvent(ctx context.Context, event *debugger.CDPEvent) {
+	// Events from auto-attached children carry their session ID. On a
+	// shared browser connection, events of other tabs are skipped before
+	// they are decoded.
+	fromChild := event.SessionID != tc.session
+	if fromChild {
+		if _, ok := tc.children[event.SessionID]; !ok {
+			return
+		}
+	}
+
+	params, err := protocol.UnmarshalEvent(event.Method, event.Params)
 	if err != nil {
-		return nil, fmt.Errorf("websocket connection error: %v", err)
+		fmt.Printf("⚠️ Skipping event: %v\n", err)
+		return
 	}
-	defer ws.Close()
 
-	if err := enableDebugging(ws); err != nil {
-		return nil, err
+	at := time.Now()
+	if fromChild && !strings.HasPrefix(event.Method, "Target.") {
+		tc.handleChildEvent(ctx, event.SessionID, params, at)
+		return
 	}
 
-	return captureDebugMessages(ws), nil
+	switch params := params.(type) {
+	case *protocol.ConsoleMessageAddedEvent:
+		key, fromConsoleAPI := legacyConsoleKey(params)
+		if fromConsoleAPI && tc.dedupe.matchLegacy(key, at) {
+			return
+		}
+		// A copy the limits dropped is remembered too, so its counterpart
+		// is not counted a second time
+		i := tc.add(parseConsoleMessage(params), at)
+		if fromConsoleAPI {
+			tc.dedupe.remember(key, i, at, true)
+		}
+	case *protocol.RuntimeConsoleAPICalledEvent:
+		key := runtimeConsoleKey(params)
+		msg, expand := parseConsoleCall(ctx, tc.page, params, tc.values)
+		tc.groups.assign(&msg, event.SessionID)
+		repeat, foldable := runtimeRepeatKey(&msg, params)
+		// The Runtime version is richer, so it replaces an earlier Console copy
+		if i, ok := tc.dedupe.matchRuntime(key, at); ok {
+			if i >= 0 {
+				tc.replace(i, msg, repeat, foldable, expand)
+			}
+			return
+		}
+		tc.dedupe.remember(key, tc.keep(msg, repeat, foldable, expand, at), at, false)
+	case *protocol.RuntimeExceptionThrownEvent:
+		tc.add(parseException(params), at)
+	case *protocol.RuntimeExceptionRevokedEvent:
+		revokeException(tc.messages, params, nil)
+	case *protocol.InspectorDetachedEvent:
+		fmt.Printf("🔌 Detached from %s: %s\n", tc.target.URL, params.Reason)
+		tc.ended = stopDetached
+		if params.Reason == "target_closed" {
+			tc.ended = stopClosed
+		}
+	case *protocol.InspectorTargetCrashedEvent:
+		fmt.Printf("💥 Target crashed: %s\n", tc.target.URL)
+		tc.ended = stopCrashed
+	case *protocol.TargetAttachedToTargetEvent:
+		tc.attachedToTarget(ctx, event.SessionID, params)
+	case *protocol.TargetDetachedFromTargetEvent:
+		tc.detachedFromTarget(params)
+	case *protocol.LogEntryAddedEvent:
+		tc.add(parseLogEntry(params), at)
+	case *protocol.AuditsIssueAddedEvent:
+		tc.add(parseIssue(event.Params), at)
+	case *protocol.DebuggerScriptParsedEvent:
+		addScript(tc.resolver, params)
+	case *protocol.PageLoadEventFiredEvent:
+		tc.stop.loadedAt = at
+	case *protocol.PageFrameNavigatedEvent:
+		tc.frameNavigated(params)
+	case *protocol.NetworkRequestWillBeSentEvent:
+		tc.network.requestWillBeSent(params)
+		tc.noteNetwork(at)
+	case *protocol.NetworkResponseReceivedEvent:
+		if failure := tc.network.responseReceived(params); failure != nil {
+			tc.publishFailure(failure)
+		}
+		tc.noteNetwork(at)
+	case *protocol.NetworkLoadingFailedEvent:
+		tc.publishFailure(tc.network.loadingFailed(params))
+		tc.noteNetwork(at)
+	case *protocol.NetworkLoadingFinishedEvent:
+		tc.network.loadingFinished(params)
+		tc.noteNetwork(at)
+	}
 }
 
-func categorizeMessages(messages []debugger.ConsoleMessage) debugger.PageResults {
-	results := debugger.PageResults{
-		Console: make([]debugger.ConsoleMessage, 0),
-		Errors:  make([]debugger.ConsoleMessage, 0),
-	}
+// add records a new message and returns its index in messages, or -1 when
+// the capture limits dropped it. Repeats of a kept message are folded into
+// it and are not published again.
+func (tc *targetCapture) add(msg debugger.ConsoleMessage, at time.Time) int {
+	key, foldable := repeatKeyOf(&msg)
+	return tc.keep(msg, key, foldable, nil, at)
+}
 
-	for _, msg := range messages {
-		switch msg.Type {
-		case "error", "exception":
-			results.Errors = append(results.Errors, msg)
-		default:
-			results.Console = append(results.Console, msg)
+// keep is add for a message whose repeat key is known. expand, when set,
+// fills in the parts of the message that cost round trips to the page; it
+// is only called once the message is neither folded nor dropped.
+func (tc *targetCapture) keep(msg debugger.ConsoleMessage, key repeatKey, foldable bool, expand func(*debugger.ConsoleMessage), at time.Time) int {
+	msg.Navigation = tc.navigation()
+	key.navigation = msg.Navigation
+	tc.stop.lastActivity = at
+	tc.stop.messages++
+	if msg.Type == "error" || msg.Type == "exception" {
+		tc.stop.errors++
+	}
+
+	if foldable {
+		if i, ok := tc.flood.repeat(tc.messages, key, msg.Time); ok {
+			return i
 		}
 	}
+	if tc.flood.full(len(tc.messages)) {
+		return -1
+	}
+	if expand != nil {
+		expand(&msg)
+	}
+	tc.flood.trim(&msg)
+	if !tc.flood.admit(&msg, len(tc.messages)) {
+		return -1
+	}
+	tc.flood.hold(&msg, key, foldable, len(tc.messages))
+	tc.messages = append(tc.messages, msg)
+	tc.publishMessage(&msg)
+	return len(tc.messages) - 1
+}
 
-	return results
+// replace swaps the Console copy of a console call at i for its Runtime
+// version. A copy that was folded into a repeated message already counts
+// the call, and a Runtime version that repeats an earlier message is
+// folded into it. Either way its arguments are not expanded.
+func (tc *targetCapture) replace(i int, msg debugger.ConsoleMessage, key repeatKey, foldable bool, expand func(*debugger.ConsoleMessage)) {
+	if tc.messages[i].RepeatCount > 0 {
+		return
+	}
+	msg.Navigation = tc.messages[i].Navigation
+	key.navigation = msg.Navigation
+	tc.flood.release(&tc.messages[i], i)
+
+	// Copies arrive close together, so the message is usually the last one
+	// and can simply be taken back
+	if foldable && i == len(tc.messages)-1 {
+		if _, ok := tc.flood.repeat(tc.messages, key, msg.Time); ok {
+			clear(tc.messages[i:])
+			tc.messages = tc.messages[:i]
+			return
+		}
+	}
+	if expand != nil {
+		expand(&msg)
+	}
+	tc.flood.trim(&msg)
+	tc.messages[i] = msg
+	tc.flood.hold(&msg, key, foldable, i)
 }
 
-func parseConsoleMessage(data map[string]interface{}) debugger.ConsoleMessage {
-	params, ok := data["params"].(map[string]interface{})
-	if !ok {
-		return debugger.ConsoleMessage{}
+func debugTarget(ctx context.Context, browser debugger.Browser, target *debugger.DebuggingTarget, req *debugger.DebugRequest, progress progressFunc) (debugger.PageResults, error) {
+	capture, err := startCapture(ctx, browser, target, req)
+	if err != nil {
+		return debugger.PageResults{}, err
 	}
+	defer capture.close()
 
-	message, ok := params["message"].(map[string]interface{})
-	if !ok {
-		return debugger.ConsoleMessage{}
+	if req.Stop.LoadQuietMs > 0 && !willNavigate(target, req) {
+		markLoadedIfComplete(ctx, capture.page, &capture.stop)
+	}
+	if err := navigateTarget(ctx, capture.page, target, req); err != nil {
+		return debugger.PageResults{}, err
 	}
+	progress.report(target, targetCapturing, nil)
 
-	return debugger.ConsoleMessage{
-		Type:    message["level"].(string),
-		Time:    time.Now(),
-		Message: message["text"].(string),
-		URL:     message["url"].(string),
+	reason := captureDebugMessages(ctx, capture, capture.events, req.Stop)
+	fmt.Printf("⏹️ Capture of %s stopped: %s\n", target.URL, reason)
+	results := capture.results(ctx, reason)
+	if results.Truncated {
+		fmt.Printf("✂️ Capture of %s truncated by %s: dropped %d messages, %d events\n",
+			target.URL, strings.Join(results.TruncatedBy, ", "), results.DroppedMessages, results.DroppedEvents)
 	}
+	return results, nil
 }
 
-func parseRuntimeConsole(ws *websocket.Conn, data map[string]interface{}) debugger.ConsoleMessage {
-	params, ok := data["params"].(map[string]interface{})
-	if !ok {
-		return debugger.ConsoleMessage{}
+// startCapture attaches to a target and enables every domain it is
+// captured from. The returned capture must be closed.
+func startCapture(ctx context.Context, browser debugger.Browser, target *debugger.DebuggingTarget, req *debugger.DebugRequest) (*targetCapture, error) {
+	conn, err := browser.Attach(ctx, target)
+	if err != nil {
+		return nil, err
 	}
 
-	args := params["args"].([]interface{})
-	var message strings.Builder
-	var skipNext bool
-	var hasObject bool
+	// Subscribe before enabling so messages replayed by Console.enable are kept
+	events := conn.Client.Subscribe(captureEvents(req)...)
 
-	for i, arg := range args {
-		argMap := arg.(map[string]interface{})
-		
-		if skipNext {
-			skipNext = false
-			continue
-		}
+	if err := enableDebugging(ctx, conn.Page, target.Type, req); err != nil {
+		events.Cancel()
+		conn.Close()
+		return nil, err
+	}
 
-		switch argMap["type"].(string) {
-		case "string":
-			if value, ok := argMap["value"].(string); ok {
-				if strings.Contains(value, "background:") || 
-				   strings.Contains(value, "color:") || 
-				   strings.Contains(value, "border-radius:") {
-					skipNext = true
-					continue
-				}
-				if value == " Server " {
-					continue
-				}
-				message.WriteString(value)
-			}
-		case "object":
-			hasObject = true
-			if objectID, ok := argMap["objectId"].(string); ok {
-				props := getObjectProperties(ws, objectID)
-				if props != nil {
-					message.WriteString(formatDetailedObject(props))
-				} else if preview, ok := argMap["preview"].(map[string]interface{}); ok {
-					message.WriteString(formatObject(preview))
-				}
-			} else if preview, ok := argMap["preview"].(map[string]interface{}); ok {
-				message.WriteString(formatObject(preview))
-			}
-		}
+	return &targetCapture{
+		target:   target,
+		conn:     conn,
+		events:   events,
+		client:   conn.Client,
+		page:     conn.Page,
+		session:  conn.SessionID,
+		resolver: sourcemap.NewResolver(sourceMaps, cfg.SourceMapDir),
+		network:  newNetworkTracker(),
+		stop:     newStopState(time.Now()),
+		children: make(map[string]*debugger.TargetInfo),
+		values:   requestValueLimits(req),
+		flood:    newFloodGuard(requestCaptureLimits(req)),
+		messages: getMessages(),
+
+		navigations: make([]debugger.Navigation, 0),
+	}, nil
+}
 
-		if i < len(args)-1 && !skipNext && !hasObject {
-			message.WriteString(" ")
-		}
-	}
+// captureEvents lists the events a capture handles. Everything else the
+// enabled domains send is dropped by the client without being decoded.
+func captureEvents(req *debugger.DebugRequest) []string {
+	methods := []string{
+		protocol.EventRuntimeConsoleAPICalled,
+		protocol.EventRuntimeExceptionThrown,
+		protocol.EventRuntimeExceptionRevoked,
+		protocol.EventDebuggerScriptParsed,
+		protocol.EventNetworkRequestWillBeSent,
+		protocol.EventNetworkResponseReceived,
+		protocol.EventNetworkLoadingFailed,
+		protocol.EventNetworkLoadingFinished,
+		protocol.EventLogEntryAdded,
+		protocol.EventAuditsIssueAdded,
+		protocol.EventPageLoadEventFired,
+		protocol.EventPageFrameNavigated,
+		protocol.EventTargetAttachedToTarget,
+		protocol.EventTargetDetachedFromTarget,
+		protocol.EventInspectorDetached,
+		protocol.EventInspectorTargetCrashed,
+	}
+	if useLegacyConsole(req) {
+		methods = append(methods, protocol.EventConsoleMessageAdded)
+	}
+	return methods
+}
 
-	return debugger.ConsoleMessage{
-		Type:    params["type"].(string),
-		Time:    time.Now(),
-		Message: strings.TrimSpace(message.String()),
-		URL:     getSourceURL(params),
-	}
+// close detaches from the target
+func (tc *targetCapture) close() {
+	tc.events.Cancel()
+	tc.conn.Close()
+	putMessages(tc.messages)
+	tc.messages = nil
 }
 
-type responseChannel struct {
-	ch      chan map[string]interface{}
-	timeout time.Time
+// results resolves source maps and packs what was captured into PageResults
+func (tc *targetCapture) results(ctx context.Context, reason string) debugger.PageResults {
+	resolveSourceMaps(ctx, tc.resolver, tc.messages, tc.network.failures)
+	tc.network.assignNavigations(tc.navigations)
+
+	results := categorizeMessages(tc.messages)
+	results.TargetID = tc.target.ID
+	results.Type = tc.target.Type
+	results.URL = tc.target.URL
+	results.Title = tc.target.Title
+	if len(tc.navigations) > 0 {
+		results.URL = tc.navigations[len(tc.navigations)-1].URL
+	}
+	results.Network = tc.network.failures
+	results.Navigations = tc.navigations
+	results.StopReason = reason
+	results.OpenedTab = tc.target.OpenURL != ""
+	tc.flood.mark(&results, tc.droppedEvents())
+	return results
 }
 
-var (
-	requestCounter   int64
-	pendingRequests = make(map[int64]responseChannel)
-	requestMutex    sync.RWMutex
-)
+// reset forgets the messages and failures already handed out by results,
+// so a long running capture can report in parts
+func (tc *targetCapture) reset() {
+	clear(tc.messages)
+	tc.messages = tc.messages[:0]
+	tc.dedupe = consoleDeduper{}
+	tc.flood.reset(tc.droppedEvents())
+	tc.network.reset()
+	tc.stop.errors = 0
+	tc.stop.messages = 0
+}
 
-func getObjectProperties(ws *websocket.Conn, objectID string) map[string]interface{} {
-	reqID := atomic.AddInt64(&requestCounter, 1)
-	responseChan := make(chan map[string]interface{}, 5)
-	
-	requestMutex.Lock()
-	pendingRequests[reqID] = responseChannel{
-		ch:      responseChan,
-		timeout: time.Now().Add(30 * time.Second),
-	}
-	requestMutex.Unlock()
-
-	defer func() {
-		requestMutex.Lock()
-		delete(pendingRequests, reqID)
-		requestMutex.Unlock()
-	}()
-
-	request := map[string]interface{}{
-		"id":     reqID,
-		"method": "Runtime.getProperties",
-		"params": map[string]interface{}{
-			"objectId":               objectID,
-			"ownProperties":          true,
-			"accessorPropertiesOnly": false,
-			"generatePreview":        true,
-		},
-	}
-
-	if err := ws.WriteJSON(request); err != nil {
-		fmt.Printf("❌ Failed to send getProperties request: %v\n", err)
-		return nil
-	}
-
-	fmt.Printf("📍 Requesting properties for object: %s (reqID: %d)\n", objectID, reqID)
-
-	select {
-	case response := <-responseChan:
-		if result, ok := response["result"].(map[string]interface{}); ok {
-			return result
-		}
-		return nil
-	case <-time.After(30 * time.Second):
-		fmt.Printf("⏰ Timeout waiting for response to reqID: %d\n", reqID)
-		return nil
+// droppedEvents is how many events the capture's subscription dropped
+func (tc *targetCapture) droppedEvents() int {
+	if tc.events == nil {
+		return 0
 	}
+	return tc.events.Dropped()
 }
 
-func handleWebSocketMessage(data map[string]interface{}) {
-	if id, ok := data["id"].(float64); ok {
-		reqID := int64(id)
-		requestMutex.RLock()
-		if respChan, exists := pendingRequests[reqID]; exists {
-			select {
-			case respChan.ch <- data:
-				fmt.Printf("✅ Sent response for reqID: %d\n", reqID)
-			default:
-				fmt.Printf("⚠️ Channel full for reqID: %d\n", reqID)
-			}
-		}
-		requestMutex.RUnlock()
-		return
+// useLegacyConsole reports whether the Console domain should be enabled
+// next to Runtime; it is unless the request turns it off
+func useLegacyConsole(req *debugger.DebugRequest) bool {
+	return req.LegacyConsole == nil || *req.LegacyConsole
+}
+
+func categorizeMessages(messages []debugger.ConsoleMessage) debugger.PageResults {
+	results := debugger.PageResults{
+		Console: make([]debugger.ConsoleMessage, 0),
+		Errors:  make([]debugger.ConsoleMessage, 0),
+		Issues:  make([]debugger.ConsoleMessage, 0),
 	}
 
-	if bytes, err := json.Marshal(data); err == nil {
-		fmt.Printf("📍 Full message: %s\n", string(bytes))
+	for _, msg := range messages {
+		switch msg.Type {
+		case "error", "exception":
+			results.Errors = append(results.Errors, msg)
+		case "issue":
+			results.Issues = append(results.Issues, msg)
+		default:
+			results.Console = append(results.Console, msg)
+		}
 	}
+
+	return results
 }
 
-func formatDetailedObject(props map[string]interface{}) string {
-	if props == nil {
-		return "[object Object]"
+func parseConsoleMessage(params *protocol.ConsoleMessageAddedEvent) debugger.ConsoleMessage {
+	return debugger.ConsoleMessage{
+		Type:    params.Message.Level,
+		Time:    time.Now(),
+		Message: params.Message.Text,
+		URL:     params.Message.URL,
+		Source:  "console",
 	}
+}
 
-	// Pre-allocate builder with estimated size
-	builder := strings.Builder{}
-	builder.Grow(256)
-	builder.WriteString("{")
-
-	if result, ok := props["result"].([]interface{}); ok {
-		for i, p := range result {
-			prop := p.(map[string]interface{})
-			if i > 0 {
-				builder.WriteString(", ")
-			}
+// parseConsoleCall parses a console call without expanding its object
+// arguments and returns the function that expands them, so calls the
+// capture limits fold or drop cost no round trips to the page
+func parseConsoleCall(ctx context.Context, client debugger.Caller, params *protocol.RuntimeConsoleAPICalledEvent, limits valueLimits) (debugger.ConsoleMessage, func(*debugger.ConsoleMessage)) {
+	msg := parseRuntimeConsole(ctx, client, params, valueLimits{})
+	expand := func(msg *debugger.ConsoleMessage) {
+		full := parseRuntimeConsole(ctx, client, params, limits)
+		msg.Message = full.Message
+		msg.Args = full.Args
+		msg.Spans = full.Spans
+		msg.Table = full.Table
+		msg.Timer = full.Timer
+	}
+	return msg, expand
+}
 
-			name := prop["name"].(string)
-			if value, ok := prop["value"].(map[string]interface{}); ok {
-				valueType := value["type"].(string)
-				switch valueType {
-				case "string":
-					fmt.Fprintf(&builder, "%q: %q", name, value["value"])
-				case "number", "boolean":
-					fmt.Fprintf(&builder, "%q: %v", name, value["value"])
-				case "object":
-					if preview, ok := value["preview"].(map[string]interface{}); ok {
-						fmt.Fprintf(&builder, "%q: %s", name, formatObject(preview))
-					} else if description, ok := value["description"].(string); ok {
-						fmt.Fprintf(&builder, "%q: %s", name, description)
-					} else {
-						fmt.Fprintf(&builder, "%q: [object Object]", name)
-					}
-				}
-			}
-		}
+func parseRuntimeConsole(ctx context.Context, client debugger.Caller, params *protocol.RuntimeConsoleAPICalledEvent, limits valueLimits) debugger.ConsoleMessage {
+	expander := newValueExpander(client, limits)
+	args := make([]*debugger.RemoteValue, 0, len(params.Args))
+	for i := range params.Args {
+		args = append(args, expander.expand(ctx, &params.Args[i], 0))
 	}
 
-	builder.WriteString("}")
-	return builder.String()
-}
+	message, spans := formatConsoleArgs(args)
 
-func formatObject(preview map[string]interface{}) string {
-	var result strings.Builder
-	result.WriteString("{")
-	
-	if properties, ok := preview["properties"].([]interface{}); ok {
-		for i, p := range properties {
-			prop := p.(map[string]interface{})
-			if i > 0 {
-				result.WriteString(", ")
-			}
-			name := prop["name"].(string)
-			value := prop["value"].(string)
-			
-			if valuePreview, ok := prop["valuePreview"].(map[string]interface{}); ok {
-				value = formatObject(valuePreview)
-			}
-			
-			result.WriteString(fmt.Sprintf("%s: %s", name, value))
-		}
+	msg := debugger.ConsoleMessage{
+		Type:       consoleLevel(params.Type),
+		APIType:    params.Type,
+		Time:       time.Now(),
+		Message:    strings.TrimSpace(message),
+		Args:       args,
+		Spans:      spans,
+		URL:        getSourceURL(params.StackTrace),
+		Source:     "runtime",
+		StackTrace: parseStackTrace(params.StackTrace),
 	}
-	
-	result.WriteString("}")
-	return result.String()
+	applyConsoleSemantics(&msg)
+	return msg
 }
 
-func getSourceURL(params map[string]interface{}) string {
-	if stackTrace, ok := params["stackTrace"].(map[string]interface{}); ok {
-		if frames, ok := stackTrace["callFrames"].([]interface{}); ok && len(frames) > 0 {
-			if frame, ok := frames[0].(map[string]interface{}); ok {
-				if url, ok := frame["url"].(string); ok {
-					return url
-				}
-			}
-		}
+// getSourceURL is the URL of the top frame of a stack trace
+func getSourceURL(stackTrace *protocol.RuntimeStackTrace) string {
+	if stackTrace == nil || len(stackTrace.CallFrames) == 0 {
+		return ""
 	}
-	return ""
-} 
\ No newline at end of file
+	return stackTrace.CallFrames[0].URL
+}
@@ -0,0 +1,110 @@
+package handlers
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"os"
+	"testing"
+	"time"
+
+	"debugger-api/internal/debugger"
+	"debugger-api/internal/protocol"
+	"debugger-api/internal/sourcemap"
+)
+
+// floodTrace is the high-volume trace the debugger package benchmarks use
+const floodTrace = "../debugger/testdata/console_flood.jsonl.gz"
+
+// benchCaller answers every command with an empty result
+type benchCaller struct{}
+
+func (benchCaller) Call(ctx context.Context, method string, params interface{}, result interface{}) error {
+	return nil
+}
+
+// loadEvents reads the events of the trace a capture subscribes to, as the
+// client would deliver them
+func loadEvents(b *testing.B, req *debugger.DebugRequest) ([]*debugger.CDPEvent, int64) {
+	f, err := os.Open(floodTrace)
+	if err != nil {
+		b.Fatal(err)
+	}
+	defer f.Close()
+
+	r, err := gzip.NewReader(f)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	wanted := make(map[string]bool)
+	for _, method := range captureEvents(req) {
+		wanted[method] = true
+	}
+
+	var events []*debugger.CDPEvent
+	var size int64
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, 1<<20)
+	for scanner.Scan() {
+		var event debugger.CDPEvent
+		if err := json.Unmarshal(scanner.Bytes(), &event); err != nil {
+			b.Fatal(err)
+		}
+		if wanted[event.Method] {
+			events = append(events, &event)
+			size += int64(len(event.Params))
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		b.Fatal(err)
+	}
+	return events, size
+}
+
+// BenchmarkHandleEvent decodes and records the trace's events the way a
+// capture of its page does. The worker the trace attaches to is registered
+// up front, since attaching sends commands over the client.
+func BenchmarkHandleEvent(b *testing.B) {
+	req := &debugger.DebugRequest{}
+	events, size := loadEvents(b, req)
+
+	children := make(map[string]*debugger.TargetInfo)
+	kept := events[:0]
+	for _, event := range events {
+		if event.Method != protocol.EventTargetAttachedToTarget {
+			kept = append(kept, event)
+			continue
+		}
+		var params protocol.TargetAttachedToTargetEvent
+		json.Unmarshal(event.Params, &params)
+		children[params.SessionID] = &debugger.TargetInfo{ID: params.TargetInfo.TargetID, Type: params.TargetInfo.Type}
+	}
+	events = kept
+
+	tc := &targetCapture{
+		target:   &debugger.DebuggingTarget{ID: "bench"},
+		client:   &debugger.CDPClient{},
+		page:     benchCaller{},
+		session:  events[0].SessionID,
+		resolver: sourcemap.NewResolver(sourceMaps, ""),
+		network:  newNetworkTracker(),
+		stop:     newStopState(time.Now()),
+		children: children,
+		values:   requestValueLimits(req),
+		flood:    newFloodGuard(requestCaptureLimits(req)),
+		messages: getMessages(),
+	}
+	ctx := context.Background()
+
+	b.SetBytes(size)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, event := range events {
+			tc.handleEvent(ctx, event)
+		}
+		tc.reset()
+	}
+}
@@ -0,0 +1,243 @@
+package handlers
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"debugger-api/internal/protocol"
+)
+
+// dedupeWindow is how far apart the Console and Runtime copies of the same
+// console call may arrive and still be considered one message
+const dedupeWindow = 2 * time.Second
+
+// dedupeKey identifies a console call by its text and where it came from.
+// Console.messageAdded only reports the first argument as text and a
+// 1-based line, so Runtime keys are built to match that shape.
+type dedupeKey struct {
+	text string
+	url  string
+	line int
+}
+
+type dedupeEntry struct {
+	key    dedupeKey
+	index  int // position in targetCapture.messages, -1 when the limits dropped it
+	at     time.Time
+	legacy bool // came from the Console domain
+	taken  bool // matched by its counterpart
+}
+
+// dedupeSlot groups the waiting entries of one key from one domain
+type dedupeSlot struct {
+	key    dedupeKey
+	legacy bool
+}
+
+// consoleDeduper pairs up Console.messageAdded and Runtime.consoleAPICalled
+// reports of the same console call. Whichever arrives second is matched
+// against the first; the Runtime version is always the one that is kept.
+// Entries are indexed by key so a console flood is matched in constant time.
+type consoleDeduper struct {
+	recent  []*dedupeEntry                // oldest first
+	waiting map[dedupeSlot][]*dedupeEntry // entries not taken yet, oldest first
+}
+
+// matchLegacy is called for a Console.messageAdded event. It reports whether
+// a Runtime copy was already recorded, in which case the event is dropped.
+func (d *consoleDeduper) matchLegacy(key dedupeKey, at time.Time) bool {
+	_, ok := d.take(key, at, false)
+	return ok
+}
+
+// matchRuntime is called for a Runtime.consoleAPICalled event. It returns the
+// index of an earlier Console copy that the Runtime message should replace,
+// or -1 when that copy was dropped.
+func (d *consoleDeduper) matchRuntime(key dedupeKey, at time.Time) (int, bool) {
+	return d.take(key, at, true)
+}
+
+// remember records a message that is still waiting for its counterpart
+func (d *consoleDeduper) remember(key dedupeKey, index int, at time.Time, legacy bool) {
+	if d.waiting == nil {
+		d.waiting = make(map[dedupeSlot][]*dedupeEntry)
+	}
+	entry := &dedupeEntry{key: key, index: index, at: at, legacy: legacy}
+	slot := dedupeSlot{key, legacy}
+	d.recent = append(d.recent, entry)
+	d.waiting[slot] = append(d.waiting[slot], entry)
+}
+
+// take finds and removes a pending entry from the other domain
+func (d *consoleDeduper) take(key dedupeKey, at time.Time, wantLegacy bool) (int, bool) {
+	d.expire(at)
+
+	slot := dedupeSlot{key, wantLegacy}
+	entries := d.waiting[slot]
+	if len(entries) == 0 {
+		return 0, false
+	}
+	entry := entries[0]
+	entry.taken = true
+	d.pop(slot, entries)
+	return entry.index, true
+}
+
+// expire drops entries too old to ever match again. Events arrive in time
+// order, so they are all at the front.
+func (d *consoleDeduper) expire(at time.Time) {
+	n := 0
+	for ; n < len(d.recent) && at.Sub(d.recent[n].at) > dedupeWindow; n++ {
+		entry := d.recent[n]
+		if !entry.taken {
+			// The oldest entry is first in its slot too
+			slot := dedupeSlot{entry.key, entry.legacy}
+			d.pop(slot, d.waiting[slot])
+		}
+		d.recent[n] = nil
+	}
+	d.recent = d.recent[n:]
+}
+
+// pop removes the first of a slot's waiting entries
+func (d *consoleDeduper) pop(slot dedupeSlot, entries []*dedupeEntry) {
+	if len(entries) <= 1 {
+		delete(d.waiting, slot)
+		return
+	}
+	entries[0] = nil
+	d.waiting[slot] = entries[1:]
+}
+
+// legacyConsoleKey builds the dedupe key of a Console.messageAdded event.
+// ok is false for messages that did not come from the console API and so
+// have no Runtime counterpart.
+func legacyConsoleKey(params *protocol.ConsoleMessageAddedEvent) (dedupeKey, bool) {
+	message := &params.Message
+	if message.Source != "console-api" {
+		return dedupeKey{}, false
+	}
+	return dedupeKey{text: message.Text, url: message.URL, line: message.Line}, true
+}
+
+// runtimeConsoleKey builds the dedupe key of a Runtime.consoleAPICalled event
+func runtimeConsoleKey(params *protocol.RuntimeConsoleAPICalledEvent) dedupeKey {
+	var key dedupeKey
+	if len(params.Args) > 0 {
+		key.text = argText(&params.Args[0])
+	}
+	if params.StackTrace != nil && len(params.StackTrace.CallFrames) > 0 {
+		frame := params.StackTrace.CallFrames[0]
+		key.url = frame.URL
+		key.line = frame.LineNumber + 1
+	}
+	return key
+}
+
+// argText converts the first console argument to a string the way V8 does
+// for the text of Console.messageAdded: primitives with toString, arrays
+// joined with commas, errors as "Name: message" and other objects as
+// Object.prototype.toString would.
+func argText(arg *protocol.RuntimeRemoteObject) string {
+	switch arg.Type {
+	case "string":
+		s, _ := arg.Value.(string)
+		return s
+	case "number":
+		if n, ok := arg.Value.(float64); ok {
+			return jsNumber(n)
+		}
+		if arg.UnserializableValue == "-0" {
+			return "0"
+		}
+		return arg.UnserializableValue
+	case "boolean":
+		return fmt.Sprint(arg.Value)
+	case "bigint":
+		// Unserializable bigints already carry V8's "n" suffix
+		return arg.UnserializableValue
+	case "undefined":
+		return "undefined"
+	case "symbol", "function":
+		return arg.Description
+	}
+
+	switch arg.Subtype {
+	case "null":
+		return "null"
+	case "array":
+		if arg.Preview == nil || arg.Preview.Overflow {
+			return arg.Description
+		}
+		return arrayText(arg.Preview, describedSize(arg.Description))
+	case "error":
+		return errorText(arg.Description)
+	case "date", "regexp":
+		return arg.Description
+	}
+	return objectTag(arg.Subtype, arg.ClassName)
+}
+
+// arrayText joins the items of an array preview like Array#join, with
+// null and undefined items and holes as empty strings
+func arrayText(preview *protocol.RuntimeObjectPreview, length int) string {
+	items := make([]string, length)
+	for i := range preview.Properties {
+		prop := &preview.Properties[i]
+		index, err := strconv.Atoi(prop.Name)
+		if err != nil || index < 0 || index >= length {
+			continue
+		}
+		items[index] = previewText(prop)
+	}
+	return strings.Join(items, ",")
+}
+
+// previewText converts an array item from its preview. Previews render
+// primitives as JavaScript does; objects only by their description.
+func previewText(prop *protocol.RuntimePropertyPreview) string {
+	if prop.Type != "object" {
+		if prop.Type == "undefined" {
+			return ""
+		}
+		return prop.Value
+	}
+
+	switch prop.Subtype {
+	case "null":
+		return ""
+	case "array":
+		if prop.ValuePreview != nil && !prop.ValuePreview.Overflow {
+			return arrayText(prop.ValuePreview, describedSize(prop.Value))
+		}
+		return prop.Value
+	case "error":
+		return errorText(prop.Value)
+	case "date", "regexp":
+		return prop.Value
+	}
+	// Descriptions of sized objects read like "Map(2)"
+	className, _, _ := strings.Cut(prop.Value, "(")
+	return objectTag(prop.Subtype, className)
+}
+
+// errorText is Error#toString of an error whose description is its stack
+func errorText(description string) string {
+	text, _, _ := strings.Cut(description, "\n    at ")
+	return text
+}
+
+// objectTag is Object.prototype.toString of an object. Built-in and host
+// objects report their class through Symbol.toStringTag; proxies are never
+// looked through.
+func objectTag(subtype, className string) string {
+	switch {
+	case subtype == "proxy":
+		className = "Proxy"
+	case className == "":
+		className = "Object"
+	}
+	return "[object " + className + "]"
+}
@@ -0,0 +1,143 @@
+package handlers
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"debugger-api/internal/protocol"
+)
+
+// consolePairs are the Console.messageAdded text and Runtime.consoleAPICalled
+// arguments Chrome reports for the same console call
+var consolePairs = []struct {
+	call string
+	text string
+	args string
+}{
+	{`console.log("hello", 1)`, "hello",
+		`[{"type":"string","value":"hello"},{"type":"number","value":1,"description":"1"}]`},
+	{`console.log(1000000)`, "1000000",
+		`[{"type":"number","value":1000000,"description":"1000000"}]`},
+	{`console.log(123456789.5)`, "123456789.5",
+		`[{"type":"number","value":123456789.5,"description":"123456789.5"}]`},
+	{`console.log(1e21)`, "1e+21",
+		`[{"type":"number","value":1e21,"description":"1e+21"}]`},
+	{`console.log(0.0000001)`, "1e-7",
+		`[{"type":"number","value":1e-7,"description":"1e-7"}]`},
+	{`console.log(-0)`, "0",
+		`[{"type":"number","unserializableValue":"-0","description":"-0"}]`},
+	{`console.log(NaN)`, "NaN",
+		`[{"type":"number","unserializableValue":"NaN","description":"NaN"}]`},
+	{`console.log(10n)`, "10n",
+		`[{"type":"bigint","unserializableValue":"10n","description":"10n"}]`},
+	{`console.log(true)`, "true",
+		`[{"type":"boolean","value":true}]`},
+	{`console.log(undefined)`, "undefined",
+		`[{"type":"undefined"}]`},
+	{`console.log(null)`, "null",
+		`[{"type":"object","subtype":"null","value":null}]`},
+	{`console.log(Symbol("id"))`, "Symbol(id)",
+		`[{"type":"symbol","description":"Symbol(id)","objectId":"1"}]`},
+	{`console.log(function foo() { return 1 })`, "function foo() { return 1 }",
+		`[{"type":"function","className":"Function","description":"function foo() { return 1 }","objectId":"1"}]`},
+	{`console.log({a: 1})`, "[object Object]",
+		`[{"type":"object","className":"Object","description":"Object","objectId":"1",
+		  "preview":{"type":"object","description":"Object","overflow":false,"properties":[{"name":"a","type":"number","value":"1"}]}}]`},
+	{`console.log(["a", "b", "c"])`, "a,b,c",
+		`[{"type":"object","subtype":"array","className":"Array","description":"Array(3)","objectId":"1",
+		  "preview":{"type":"object","subtype":"array","description":"Array(3)","overflow":false,"properties":[
+		    {"name":"0","type":"string","value":"a"},{"name":"1","type":"string","value":"b"},{"name":"2","type":"string","value":"c"}]}}]`},
+	{`console.log([1000000, null, undefined, [2, 3], {}, , true])`, "1000000,,,2,3,[object Object],,true",
+		`[{"type":"object","subtype":"array","className":"Array","description":"Array(7)","objectId":"1",
+		  "preview":{"type":"object","subtype":"array","description":"Array(7)","overflow":false,"properties":[
+		    {"name":"0","type":"number","value":"1000000"},
+		    {"name":"1","type":"object","subtype":"null","value":"null"},
+		    {"name":"2","type":"undefined","value":"undefined"},
+		    {"name":"3","type":"object","subtype":"array","value":"Array(2)","valuePreview":{"type":"object","subtype":"array","description":"Array(2)","overflow":false,"properties":[
+		      {"name":"0","type":"number","value":"2"},{"name":"1","type":"number","value":"3"}]}},
+		    {"name":"4","type":"object","value":"Object"},
+		    {"name":"6","type":"boolean","value":"true"}]}}]`},
+	{`console.table(metrics)`, "[object Object],[object Object]",
+		`[{"type":"object","subtype":"array","className":"Array","description":"Array(2)","objectId":"1",
+		  "preview":{"type":"object","subtype":"array","description":"Array(2)","overflow":false,"properties":[
+		    {"name":"0","type":"object","value":"Object"},{"name":"1","type":"object","value":"Object"}]}}]`},
+	{`console.log([new Map()])`, "[object Map]",
+		`[{"type":"object","subtype":"array","className":"Array","description":"Array(1)","objectId":"1",
+		  "preview":{"type":"object","subtype":"array","description":"Array(1)","overflow":false,"properties":[
+		    {"name":"0","type":"object","subtype":"map","value":"Map(0)"}]}}]`},
+	{`console.log(new Map([["k", 1]]))`, "[object Map]",
+		`[{"type":"object","subtype":"map","className":"Map","description":"Map(1)","objectId":"1"}]`},
+	{`console.log(document.body)`, "[object HTMLBodyElement]",
+		`[{"type":"object","subtype":"node","className":"HTMLBodyElement","description":"body","objectId":"1"}]`},
+	{`console.log(new Proxy({}, {}))`, "[object Proxy]",
+		`[{"type":"object","subtype":"proxy","className":"Object","description":"Proxy(Object)","objectId":"1"}]`},
+	{`console.log(new TypeError("bad thing"))`, "TypeError: bad thing",
+		`[{"type":"object","subtype":"error","className":"TypeError","description":"TypeError: bad thing\n    at load (http://localhost:3000/app.js:5:11)\n    at http://localhost:3000/app.js:9:1","objectId":"1"}]`},
+	{`console.log(new Error("two\nlines"))`, "Error: two\nlines",
+		`[{"type":"object","subtype":"error","className":"Error","description":"Error: two\nlines\n    at http://localhost:3000/app.js:5:13","objectId":"1"}]`},
+	{`console.log(/a+/g)`, "/a+/g",
+		`[{"type":"object","subtype":"regexp","className":"RegExp","description":"/a+/g","objectId":"1"}]`},
+	{`console.log(new Date(0))`, "Thu Jan 01 1970 00:00:00 GMT+0000 (Coordinated Universal Time)",
+		`[{"type":"object","subtype":"date","className":"Date","description":"Thu Jan 01 1970 00:00:00 GMT+0000 (Coordinated Universal Time)","objectId":"1"}]`},
+}
+
+func TestConsoleKeysMatch(t *testing.T) {
+	for _, pair := range consolePairs {
+		text, _ := json.Marshal(pair.text)
+		var legacy protocol.ConsoleMessageAddedEvent
+		if err := json.Unmarshal([]byte(`{"message":{"source":"console-api","level":"log","text":`+string(text)+
+			`,"url":"http://localhost:3000/app.js","line":5,"column":9}}`), &legacy); err != nil {
+			t.Fatalf("%s: %v", pair.call, err)
+		}
+		var runtime protocol.RuntimeConsoleAPICalledEvent
+		if err := json.Unmarshal([]byte(`{"type":"log","executionContextId":1,"timestamp":1700000000000,"args":`+pair.args+
+			`,"stackTrace":{"callFrames":[{"functionName":"","scriptId":"7","url":"http://localhost:3000/app.js","lineNumber":4,"columnNumber":8}]}}`), &runtime); err != nil {
+			t.Fatalf("%s: %v", pair.call, err)
+		}
+
+		legacyKey, ok := legacyConsoleKey(&legacy)
+		if !ok {
+			t.Fatalf("%s: no key for the Console copy", pair.call)
+		}
+		if runtimeKey := runtimeConsoleKey(&runtime); runtimeKey != legacyKey {
+			t.Errorf("%s: Runtime key %+v, Console key %+v", pair.call, runtimeKey, legacyKey)
+		}
+	}
+}
+
+func TestConsoleDeduper(t *testing.T) {
+	key := dedupeKey{text: "hello", url: "http://localhost:3000/app.js", line: 5}
+	other := dedupeKey{text: "other", url: key.url, line: key.line}
+	start := time.Now()
+
+	var d consoleDeduper
+	d.remember(key, 0, start, true)
+	d.remember(key, 1, start, true)
+	if _, ok := d.matchRuntime(other, start); ok {
+		t.Error("a different call matched")
+	}
+	if i, ok := d.matchRuntime(key, start); !ok || i != 0 {
+		t.Errorf("first Runtime copy matched %d, %v, want 0", i, ok)
+	}
+	if i, ok := d.matchRuntime(key, start); !ok || i != 1 {
+		t.Errorf("second Runtime copy matched %d, %v, want 1", i, ok)
+	}
+	if _, ok := d.matchRuntime(key, start); ok {
+		t.Error("a Console copy matched twice")
+	}
+
+	d.remember(key, 2, start, false)
+	if d.matchLegacy(key, start.Add(dedupeWindow+time.Millisecond)) {
+		t.Error("a copy outside the dedupe window matched")
+	}
+}
+
+func TestLegacyConsoleKeySkipsOtherSources(t *testing.T) {
+	var params protocol.ConsoleMessageAddedEvent
+	params.Message.Source = "network"
+	params.Message.Text = "Failed to load resource"
+	if _, ok := legacyConsoleKey(&params); ok {
+		t.Error("a network message has a console key")
+	}
+}
@@ -0,0 +1,115 @@
+package handlers
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"debugger-api/internal/debugger"
+)
+
+// maxCauseDepth bounds how many cause errors are followed
+const maxCauseDepth = 8
+
+// standardErrorProperties are the own properties every Error has; anything
+// else on an error is custom and kept in ErrorRecord.Properties
+var standardErrorProperties = map[string]bool{
+	"stack":   true,
+	"message": true,
+	"name":    true,
+	"cause":   true,
+}
+
+// errorRecord takes apart the value tree of an Error. The name, message
+// and stack come from its own properties when they were expanded and from
+// the description otherwise, which V8 sets to the stack.
+func errorRecord(v *debugger.RemoteValue) *debugger.ErrorRecord {
+	return errorRecordDepth(v, 0)
+}
+
+func errorRecordDepth(v *debugger.RemoteValue, depth int) *debugger.ErrorRecord {
+	record := &debugger.ErrorRecord{}
+	stack := v.Description
+	var name string
+	var hasMessage bool
+	var cause *debugger.RemoteValue
+
+	for _, prop := range v.Properties {
+		switch prop.Name {
+		case "stack":
+			if s, ok := prop.Value.Value.(string); ok {
+				stack = s
+			}
+		case "message":
+			record.Message, hasMessage = prop.Value.Value.(string)
+		case "name":
+			name, _ = prop.Value.Value.(string)
+		case "cause":
+			cause = prop.Value
+		}
+		if !standardErrorProperties[prop.Name] {
+			record.Properties = append(record.Properties, prop)
+		}
+	}
+
+	// The first line of the stack is "<name>: <message>"
+	header, _, _ := strings.Cut(stack, "\n    at ")
+	if hasMessage && record.Message != "" {
+		header = strings.TrimSuffix(header, ": "+record.Message)
+	} else if i := strings.Index(header, ": "); i >= 0 {
+		header, record.Message = header[:i], header[i+2:]
+	}
+	switch {
+	case name != "":
+		record.Name = name
+	case header != "":
+		record.Name = header
+	default:
+		record.Name = v.ClassName
+	}
+
+	record.Stack = parseV8Stack(stack)
+
+	if cause != nil && depth < maxCauseDepth {
+		if cause.Subtype == "error" {
+			record.Cause = errorRecordDepth(cause, depth+1)
+		} else {
+			// Anything can be a cause; keep non-errors as a message
+			record.Cause = &debugger.ErrorRecord{Message: renderValue(cause, false)}
+		}
+	}
+	return record
+}
+
+// v8Frame matches "at fn (url:line:col)" and "at url:line:col" stack lines
+var v8Frame = regexp.MustCompile(`^\s*at (?:(.*?) \()?(.*?):(\d+):(\d+)\)?$`)
+
+// parseV8Stack reads the frames of a V8 stack string. Line and column
+// numbers are made zero-based like the protocol's, so the frames can be
+// resolved through source maps.
+func parseV8Stack(stack string) *debugger.StackTrace {
+	var frames []debugger.CallFrame
+	for _, line := range strings.Split(stack, "\n") {
+		match := v8Frame.FindStringSubmatch(line)
+		if match == nil {
+			continue
+		}
+		lineNumber, _ := strconv.Atoi(match[3])
+		columnNumber, _ := strconv.Atoi(match[4])
+		// Anonymous async frames read "at async url:line:col"
+		url := match[2]
+		if match[1] == "" {
+			url = strings.TrimPrefix(url, "async ")
+		}
+		frames = append(frames, debugger.CallFrame{
+			FunctionName: strings.TrimPrefix(match[1], "async "),
+			URL:          url,
+			LineNumber:   lineNumber - 1,
+			ColumnNumber: columnNumber - 1,
+		})
+	}
+	if frames == nil {
+		return nil
+	}
+	return &debugger.StackTrace{CallFrames: frames}
+}
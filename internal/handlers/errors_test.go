@@ -0,0 +1,117 @@
+package handlers
+
+import (
+	"reflect"
+	"testing"
+
+	"debugger-api/internal/debugger"
+)
+
+func stringValue(s string) *debugger.RemoteValue {
+	return &debugger.RemoteValue{Type: "string", Value: s}
+}
+
+func errorValue(className, description string, props ...debugger.RemoteProperty) *debugger.RemoteValue {
+	return &debugger.RemoteValue{Type: "object", Subtype: "error", ClassName: className, Description: description, Properties: props}
+}
+
+func TestErrorRecord(t *testing.T) {
+	frame := func(fn string, line, column int) debugger.CallFrame {
+		return debugger.CallFrame{FunctionName: fn, URL: "http://localhost:3000/app.js", LineNumber: line, ColumnNumber: column}
+	}
+	stack := func(frames ...debugger.CallFrame) *debugger.StackTrace {
+		return &debugger.StackTrace{CallFrames: frames}
+	}
+
+	tests := []struct {
+		name  string
+		value *debugger.RemoteValue
+		want  *debugger.ErrorRecord
+	}{
+		{
+			name:  "description only",
+			value: errorValue("TypeError", "TypeError: bad thing\n    at load (http://localhost:3000/app.js:5:11)\n    at http://localhost:3000/app.js:9:1"),
+			want:  &debugger.ErrorRecord{Name: "TypeError", Message: "bad thing", Stack: stack(frame("load", 4, 10), frame("", 8, 0))},
+		},
+		{
+			name:  "multi-line message",
+			value: errorValue("Error", "Error: two\nlines\n    at http://localhost:3000/app.js:5:13"),
+			want:  &debugger.ErrorRecord{Name: "Error", Message: "two\nlines", Stack: stack(frame("", 4, 12))},
+		},
+		{
+			name:  "message containing a colon",
+			value: errorValue("Error", "Error: config: missing key\n    at load (http://localhost:3000/app.js:5:11)"),
+			want:  &debugger.ErrorRecord{Name: "Error", Message: "config: missing key", Stack: stack(frame("load", 4, 10))},
+		},
+		{
+			name: "name and message properties",
+			value: errorValue("ValidationError", "ValidationError: field: too long\n    at check (http://localhost:3000/app.js:12:5)",
+				debugger.RemoteProperty{Name: "message", Value: stringValue("field: too long")},
+				debugger.RemoteProperty{Name: "name", Value: stringValue("ValidationError")}),
+			want: &debugger.ErrorRecord{Name: "ValidationError", Message: "field: too long", Stack: stack(frame("check", 11, 4))},
+		},
+		{
+			name:  "no stack",
+			value: errorValue("Error", "Error: thrown from eval"),
+			want:  &debugger.ErrorRecord{Name: "Error", Message: "thrown from eval"},
+		},
+		{
+			name:  "no message",
+			value: errorValue("RangeError", "RangeError\n    at http://localhost:3000/app.js:1:1"),
+			want:  &debugger.ErrorRecord{Name: "RangeError", Stack: stack(frame("", 0, 0))},
+		},
+		{
+			name: "async frames",
+			value: errorValue("Error", "Error: failed\n    at fetchUser (http://localhost:3000/app.js:20:9)\n"+
+				"    at async load (http://localhost:3000/app.js:30:3)\n    at async http://localhost:3000/app.js:40:1\n    at async Promise.all (index 0)"),
+			want: &debugger.ErrorRecord{Name: "Error", Message: "failed", Stack: stack(frame("fetchUser", 19, 8), frame("load", 29, 2), frame("", 39, 0))},
+		},
+		{
+			name:  "constructor and method frames",
+			value: errorValue("Error", "Error: nope\n    at new Store (http://localhost:3000/app.js:3:7)\n    at Object.<anonymous> (http://localhost:3000/app.js:8:2)"),
+			want:  &debugger.ErrorRecord{Name: "Error", Message: "nope", Stack: stack(frame("new Store", 2, 6), frame("Object.<anonymous>", 7, 1))},
+		},
+		{
+			name: "custom code",
+			value: errorValue("Error", "Error: connect ECONNREFUSED\n    at http://localhost:3000/app.js:5:13",
+				debugger.RemoteProperty{Name: "message", Value: stringValue("connect ECONNREFUSED")},
+				debugger.RemoteProperty{Name: "code", Value: stringValue("ECONNREFUSED")}),
+			want: &debugger.ErrorRecord{Name: "Error", Message: "connect ECONNREFUSED", Stack: stack(frame("", 4, 12)),
+				Properties: []debugger.RemoteProperty{{Name: "code", Value: stringValue("ECONNREFUSED")}}},
+		},
+		{
+			name: "error cause",
+			value: errorValue("Error", "Error: outer\n    at http://localhost:3000/app.js:9:1",
+				debugger.RemoteProperty{Name: "cause", Value: errorValue("TypeError", "TypeError: inner\n    at load (http://localhost:3000/app.js:5:11)")}),
+			want: &debugger.ErrorRecord{Name: "Error", Message: "outer", Stack: stack(frame("", 8, 0)),
+				Cause: &debugger.ErrorRecord{Name: "TypeError", Message: "inner", Stack: stack(frame("load", 4, 10))}},
+		},
+		{
+			name: "non-Error cause",
+			value: errorValue("Error", "Error: request failed\n    at http://localhost:3000/app.js:9:1",
+				debugger.RemoteProperty{Name: "cause", Value: stringValue("timeout")}),
+			want: &debugger.ErrorRecord{Name: "Error", Message: "request failed", Stack: stack(frame("", 8, 0)),
+				Cause: &debugger.ErrorRecord{Message: "timeout"}},
+		},
+	}
+
+	for _, tt := range tests {
+		if got := errorRecord(tt.value); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: errorRecord = %+v, want %+v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestErrorRecordCauseDepth(t *testing.T) {
+	// An error that is its own cause
+	v := errorValue("Error", "Error: loop")
+	v.Properties = []debugger.RemoteProperty{{Name: "cause", Value: v}}
+
+	depth := 0
+	for record := errorRecord(v); record != nil; record = record.Cause {
+		depth++
+	}
+	if depth != maxCauseDepth+1 {
+		t.Errorf("followed %d errors, want %d", depth, maxCauseDepth+1)
+	}
+}
@@ -0,0 +1,83 @@
+package handlers
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"debugger-api/internal/debugger"
+	"debugger-api/internal/protocol"
+)
+
+// parseException turns a Runtime.exceptionThrown event into an "exception"
+// message. Unhandled promise rejections arrive through the same event with
+// an "Uncaught (in promise)" text.
+func parseException(params *protocol.RuntimeExceptionThrownEvent) debugger.ConsoleMessage {
+	details := &params.ExceptionDetails
+
+	exception := &debugger.ExceptionDetails{
+		ExceptionID:      details.ExceptionID,
+		Text:             details.Text,
+		LineNumber:       details.LineNumber,
+		ColumnNumber:     details.ColumnNumber,
+		ScriptID:         details.ScriptID,
+		URL:              details.URL,
+		PromiseRejection: strings.HasPrefix(details.Text, "Uncaught (in promise)"),
+	}
+
+	if thrown := details.Exception; thrown != nil {
+		if thrown.Description != "" {
+			exception.Description = thrown.Description
+		} else if n, ok := thrown.Value.(float64); ok {
+			exception.Description = jsNumber(n)
+		} else if thrown.Value != nil {
+			exception.Description = fmt.Sprint(thrown.Value)
+		}
+	}
+
+	// Text is just "Uncaught" for most throws, the description carries the rest
+	message := exception.Text
+	if exception.Description != "" {
+		firstLine, _, _ := strings.Cut(exception.Description, "\n")
+		message = strings.TrimSpace(message + " " + firstLine)
+	}
+
+	url := exception.URL
+	if url == "" {
+		url = getSourceURL(details.StackTrace)
+	}
+
+	return debugger.ConsoleMessage{
+		Type:       "exception",
+		Time:       eventTime(params.Timestamp),
+		Message:    message,
+		URL:        url,
+		Source:     "runtime",
+		StackTrace: parseStackTrace(details.StackTrace),
+		Exception:  exception,
+	}
+}
+
+// revokeException marks a previously recorded exception as revoked, which
+// Chrome reports when a rejected promise gets a handler after the fact.
+// Exception IDs are per target, so origin must match too.
+func revokeException(messages []debugger.ConsoleMessage, params *protocol.RuntimeExceptionRevokedEvent, origin *debugger.TargetInfo) {
+	for i := range messages {
+		if messages[i].Target != origin {
+			continue
+		}
+		if exception := messages[i].Exception; exception != nil && exception.ExceptionID == params.ExceptionID {
+			exception.Revoked = true
+			exception.RevokeReason = params.Reason
+			return
+		}
+	}
+}
+
+// eventTime reads the millisecond epoch timestamp Runtime events carry
+func eventTime(timestamp protocol.RuntimeTimestamp) time.Time {
+	if timestamp > 0 {
+		return time.UnixMilli(int64(timestamp))
+	}
+	return time.Now()
+}
@@ -0,0 +1,176 @@
+package handlers
+
+import (
+	"errors"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"debugger-api/internal/debugger"
+)
+
+// formatConsoleArgs renders console arguments the way the console API's
+// Formatter does: a string first argument is a format string whose %s %d
+// %i %f %o %O and %c specifiers consume the following arguments, and the
+// arguments left over are appended separated by spaces. Text styled with
+// %c is returned as spans; spans is nil when no %c was used.
+func formatConsoleArgs(args []*debugger.RemoteValue) (string, []debugger.StyledSpan) {
+	f := &consoleFormatter{}
+
+	rest := args
+	if len(args) > 0 && args[0].Type == "string" {
+		format, _ := args[0].Value.(string)
+		rest = f.format(format, args[1:])
+	}
+
+	for _, arg := range rest {
+		if f.current.Len() > 0 || len(f.spans) > 0 {
+			f.current.WriteString(" ")
+		}
+		f.current.WriteString(renderValue(arg, false))
+	}
+	f.endSpan()
+
+	var message strings.Builder
+	for _, span := range f.spans {
+		message.WriteString(span.Text)
+	}
+	if !f.styled {
+		return message.String(), nil
+	}
+	return message.String(), f.spans
+}
+
+type consoleFormatter struct {
+	spans   []debugger.StyledSpan
+	current strings.Builder
+	style   string
+	styled  bool // a %c was seen
+}
+
+// format substitutes the specifiers of format and returns the arguments
+// it did not consume
+func (f *consoleFormatter) format(format string, args []*debugger.RemoteValue) []*debugger.RemoteValue {
+	for i := 0; i < len(format); i++ {
+		if format[i] != '%' || i+1 == len(format) {
+			f.current.WriteByte(format[i])
+			continue
+		}
+
+		spec := format[i+1]
+		if spec == '%' {
+			f.current.WriteByte('%')
+			i++
+			continue
+		}
+		if !strings.ContainsRune("sdifoOc", rune(spec)) || len(args) == 0 {
+			// Unknown specifiers and ones without an argument stay as they are
+			f.current.WriteByte('%')
+			continue
+		}
+
+		arg := args[0]
+		args = args[1:]
+		i++
+
+		switch spec {
+		case 's':
+			f.current.WriteString(renderValue(arg, arg.Type != "string"))
+		case 'd', 'i':
+			f.current.WriteString(formatInteger(arg))
+		case 'f':
+			f.current.WriteString(formatFloat(arg))
+		case 'o', 'O':
+			f.current.WriteString(renderValue(arg, true))
+		case 'c':
+			f.endSpan()
+			f.style, _ = arg.Value.(string)
+			f.styled = true
+		}
+	}
+	return args
+}
+
+func (f *consoleFormatter) endSpan() {
+	if f.current.Len() > 0 {
+		f.spans = append(f.spans, debugger.StyledSpan{Text: f.current.String(), Style: f.style})
+	}
+	f.current.Reset()
+}
+
+// formatInteger converts an argument like parseInt for %d and %i
+func formatInteger(arg *debugger.RemoteValue) string {
+	switch arg.Type {
+	case "bigint":
+		return arg.Unserializable
+	case "number":
+		if n, ok := arg.Value.(float64); ok {
+			return jsNumber(math.Trunc(n))
+		}
+		if arg.Unserializable == "-0" {
+			return "0"
+		}
+		return arg.Unserializable
+	case "string":
+		s, _ := arg.Value.(string)
+		if n, ok := parsePrefix(integerPrefix, s); ok {
+			return jsNumber(n)
+		}
+	}
+	return "NaN"
+}
+
+// formatFloat converts an argument like parseFloat for %f
+func formatFloat(arg *debugger.RemoteValue) string {
+	switch arg.Type {
+	case "number":
+		if n, ok := arg.Value.(float64); ok {
+			return jsNumber(n)
+		}
+		return arg.Unserializable
+	case "string":
+		s, _ := arg.Value.(string)
+		if n, ok := parsePrefix(floatPrefix, s); ok {
+			return jsNumber(n)
+		}
+	}
+	return "NaN"
+}
+
+// The numbers parseInt and parseFloat read from the start of a string,
+// ignoring whatever follows
+var (
+	integerPrefix = regexp.MustCompile(`^[+-]?\d+`)
+	floatPrefix   = regexp.MustCompile(`^[+-]?(Infinity|(\d+\.?\d*|\.\d+)([eE][+-]?\d+)?)`)
+)
+
+func parsePrefix(prefix *regexp.Regexp, s string) (float64, bool) {
+	match := prefix.FindString(strings.TrimLeft(s, " \t\n\r\f\v"))
+	if match == "" {
+		return 0, false
+	}
+	n, err := strconv.ParseFloat(match, 64)
+	return n, err == nil || errors.Is(err, strconv.ErrRange)
+}
+
+// jsNumber formats a number like JavaScript's Number#toString: plain
+// decimals from 1e-6 up to 1e21, exponent notation outside of that
+func jsNumber(n float64) string {
+	switch {
+	case math.IsNaN(n):
+		return "NaN"
+	case math.IsInf(n, 1):
+		return "Infinity"
+	case math.IsInf(n, -1):
+		return "-Infinity"
+	case n == 0:
+		return "0"
+	}
+	if abs := math.Abs(n); abs >= 1e-6 && abs < 1e21 {
+		return strconv.FormatFloat(n, 'f', -1, 64)
+	}
+	// Go pads the exponent to two digits ("1e-08"), JavaScript does not
+	mantissa, exponent, _ := strings.Cut(strconv.FormatFloat(n, 'e', -1, 64), "e")
+	return mantissa + "e" + exponent[:1] + strings.TrimLeft(exponent[1:], "0")
+}
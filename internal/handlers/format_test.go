@@ -0,0 +1,144 @@
+package handlers
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+
+	"debugger-api/internal/debugger"
+)
+
+func TestJSNumber(t *testing.T) {
+	tests := []struct {
+		n    float64
+		want string
+	}{
+		{0, "0"},
+		{math.Copysign(0, -1), "0"},
+		{1, "1"},
+		{-42, "-42"},
+		{1.5, "1.5"},
+		{0.1, "0.1"},
+		{1000000, "1000000"},
+		{123456789, "123456789"},
+		{1 << 53, "9007199254740992"},
+		{1e20, "100000000000000000000"},
+		{1e21, "1e+21"},
+		{1.5e300, "1.5e+300"},
+		{0.0000001, "1e-7"},
+		{0.000001, "0.000001"},
+		{1.25e-10, "1.25e-10"},
+		{math.NaN(), "NaN"},
+		{math.Inf(1), "Infinity"},
+		{math.Inf(-1), "-Infinity"},
+	}
+	for _, tt := range tests {
+		if got := jsNumber(tt.n); got != tt.want {
+			t.Errorf("jsNumber(%v) = %q, want %q", tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestRenderNumbers(t *testing.T) {
+	array := &debugger.RemoteValue{Type: "object", Subtype: "array", Description: "Array(2)",
+		Items: []*debugger.RemoteValue{num(1e21), num(0.1)}}
+
+	tests := []struct {
+		args []*debugger.RemoteValue
+		want string
+	}{
+		{[]*debugger.RemoteValue{num(1e21)}, "1e+21"},
+		{[]*debugger.RemoteValue{num(1e-7)}, "1e-7"},
+		{[]*debugger.RemoteValue{num(123456789)}, "123456789"},
+		{[]*debugger.RemoteValue{num(0.1)}, "0.1"},
+		{[]*debugger.RemoteValue{array}, "[1e+21, 0.1]"},
+		{[]*debugger.RemoteValue{str("%s"), num(1e21)}, "1e+21"},
+		{[]*debugger.RemoteValue{str("%o"), array}, "[1e+21, 0.1]"},
+		{[]*debugger.RemoteValue{str("%f"), num(2e-7)}, "2e-7"},
+	}
+	for _, tt := range tests {
+		if got, _ := formatConsoleArgs(tt.args); got != tt.want {
+			t.Errorf("formatConsoleArgs(%s) = %q, want %q", describeArgs(tt.args), got, tt.want)
+		}
+	}
+}
+
+func TestFormatConsoleArgs(t *testing.T) {
+	object := &debugger.RemoteValue{Type: "object", ClassName: "Object", Description: "Object",
+		Properties: []debugger.RemoteProperty{{Name: "a", Value: num(1)}, {Name: "b", Value: str("x")}}}
+	names := &debugger.RemoteValue{Type: "object", Subtype: "array", Description: "Array(2)",
+		Items: []*debugger.RemoteValue{str("Ada"), str("Alan")}}
+	serverStyle := "background: #e6e6e6;background: light-dark(rgba(0,0,0,0.1), rgba(255,255,255,0.25));color: #000000;border-radius: 2px"
+
+	tests := []struct {
+		args  []*debugger.RemoteValue
+		want  string
+		spans []debugger.StyledSpan
+	}{
+		{[]*debugger.RemoteValue{str("%s is %d years"), str("Ada"), num(36.7)}, "Ada is 36 years", nil},
+		{[]*debugger.RemoteValue{str("%i items"), str("42.9px")}, "42 items", nil},
+		{[]*debugger.RemoteValue{str("%i"), str("  -7.9")}, "-7", nil},
+		{[]*debugger.RemoteValue{str("%d"), str("1e3")}, "1", nil},
+		{[]*debugger.RemoteValue{str("%d"), str("abc")}, "NaN", nil},
+		{[]*debugger.RemoteValue{str("%d"), {Type: "bigint", Unserializable: "10n"}}, "10n", nil},
+		{[]*debugger.RemoteValue{str("%d"), {Type: "number", Unserializable: "-0"}}, "0", nil},
+		{[]*debugger.RemoteValue{str("%f"), str("3.5rem")}, "3.5", nil},
+		{[]*debugger.RemoteValue{str("%f"), str("1e3x")}, "1000", nil},
+		{[]*debugger.RemoteValue{str("%f"), str(" -Infinity!")}, "-Infinity", nil},
+		{[]*debugger.RemoteValue{str("%f"), {Type: "boolean", Value: true}}, "NaN", nil},
+		{[]*debugger.RemoteValue{str("%s"), object}, "{a: 1, b: \"x\"}", nil},
+		{[]*debugger.RemoteValue{str("%o"), str("text")}, "\"text\"", nil},
+		{[]*debugger.RemoteValue{str("%O"), object}, "{a: 1, b: \"x\"}", nil},
+		{[]*debugger.RemoteValue{str("100%%")}, "100%", nil},
+		{[]*debugger.RemoteValue{str("%%s"), str("a")}, "%s a", nil},
+		// Specifiers without an argument and unknown ones stay as they are
+		{[]*debugger.RemoteValue{str("%s and %s"), str("a")}, "a and %s", nil},
+		{[]*debugger.RemoteValue{str("%x %s"), str("a")}, "%x a", nil},
+		{[]*debugger.RemoteValue{str("50%")}, "50%", nil},
+		// Arguments left over are appended
+		{[]*debugger.RemoteValue{str("a"), str("b"), num(1)}, "a b 1", nil},
+		{[]*debugger.RemoteValue{num(1), str("%s")}, "1 %s", nil},
+		{[]*debugger.RemoteValue{str("%s:"), str("names"), names}, "names: [\"Ada\", \"Alan\"]", nil},
+		{nil, "", nil},
+		// %c starts a styled span
+		{[]*debugger.RemoteValue{str("%cred%c plain"), str("color: red"), str("")}, "red plain",
+			[]debugger.StyledSpan{{Text: "red", Style: "color: red"}, {Text: " plain"}}},
+		{[]*debugger.RemoteValue{str("%c%s%c The names are:"), str(serverStyle), str(" Server "), str(""), names},
+			" Server  The names are: [\"Ada\", \"Alan\"]",
+			[]debugger.StyledSpan{{Text: " Server ", Style: serverStyle}, {Text: " The names are: [\"Ada\", \"Alan\"]"}}},
+	}
+	for _, tt := range tests {
+		got, spans := formatConsoleArgs(tt.args)
+		if got != tt.want {
+			t.Errorf("formatConsoleArgs(%s) = %q, want %q", describeArgs(tt.args), got, tt.want)
+		}
+		if !reflect.DeepEqual(spans, tt.spans) {
+			t.Errorf("formatConsoleArgs(%s) spans = %+v, want %+v", describeArgs(tt.args), spans, tt.spans)
+		}
+	}
+}
+
+func str(s string) *debugger.RemoteValue {
+	return &debugger.RemoteValue{Type: "string", Value: s}
+}
+
+func num(n float64) *debugger.RemoteValue {
+	return &debugger.RemoteValue{Type: "number", Value: n}
+}
+
+func describeArgs(args []*debugger.RemoteValue) string {
+	parts := make([]string, len(args))
+	for i, arg := range args {
+		switch {
+		case arg.Description != "":
+			parts[i] = arg.Description
+		case arg.Unserializable != "":
+			parts[i] = arg.Unserializable
+		default:
+			parts[i] = fmt.Sprint(arg.Value)
+		}
+	}
+	return strings.Join(parts, ", ")
+}
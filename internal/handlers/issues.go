@@ -0,0 +1,136 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"debugger-api/internal/debugger"
+	"debugger-api/internal/protocol"
+)
+
+// parseLogEntry maps a Log.entryAdded event (violations, interventions,
+// deprecations, security and network errors reported by the browser itself)
+// into a console message. The entry's source becomes the category.
+func parseLogEntry(params *protocol.LogEntryAddedEvent) debugger.ConsoleMessage {
+	entry := &params.Entry
+
+	msg := debugger.ConsoleMessage{
+		Type:       entry.Level,
+		Time:       eventTime(entry.Timestamp),
+		Message:    entry.Text,
+		URL:        entry.URL,
+		Source:     "log",
+		Category:   entry.Source,
+		StackTrace: parseStackTrace(entry.StackTrace),
+	}
+
+	// CORS errors carry a more specific category alongside source "network"
+	if entry.Category != "" {
+		msg.Category = entry.Category
+	}
+	return msg
+}
+
+// issueDetails holds the fields most "<kind>IssueDetails" objects share,
+// so every kind of issue is read the same way
+type issueDetails struct {
+	Request            *protocol.AuditsAffectedRequest    `json:"request"`
+	Cookie             *protocol.AuditsAffectedCookie     `json:"cookie"`
+	SourceCodeLocation *protocol.AuditsSourceCodeLocation `json:"sourceCodeLocation"`
+	BlockedURL         string                             `json:"blockedURL"`
+	InsecureURL        string                             `json:"insecureURL"`
+	CookieURL          string                             `json:"cookieUrl"`
+	URL                string                             `json:"url"`
+}
+
+// issueAddedEvent is Audits.issueAdded with its details left undecoded.
+// The generated AuditsInspectorIssueDetails only knows the kinds of issue
+// and fields of the vendored protocol and would drop newer ones.
+type issueAddedEvent struct {
+	Issue struct {
+		Code    string          `json:"code"`
+		IssueID string          `json:"issueId"`
+		Details json.RawMessage `json:"details"`
+	} `json:"issue"`
+}
+
+// parseIssue maps the params of an Audits.issueAdded event into an "issue"
+// message. The issue-specific details object is kept byte for byte; the
+// affected request and cookie are lifted out since almost every issue type
+// has one of them.
+func parseIssue(params json.RawMessage) debugger.ConsoleMessage {
+	var event issueAddedEvent
+	if err := json.Unmarshal(params, &event); err != nil {
+		fmt.Printf("⚠️ Failed to read issue details: %v\n", err)
+	}
+	issue := &debugger.Issue{
+		Code:    event.Issue.Code,
+		IssueID: event.Issue.IssueID,
+		Details: event.Issue.Details,
+	}
+
+	// details holds a single "<kind>IssueDetails" object
+	var details issueDetails
+	var wrapper map[string]json.RawMessage
+	json.Unmarshal(event.Issue.Details, &wrapper)
+	for _, raw := range wrapper {
+		json.Unmarshal(raw, &details)
+		break
+	}
+
+	if details.Request != nil {
+		issue.AffectedRequest = &debugger.AffectedRequest{
+			RequestID: details.Request.RequestID,
+			URL:       details.Request.URL,
+		}
+	}
+	if details.Cookie != nil {
+		issue.AffectedCookie = &debugger.AffectedCookie{
+			Name:   details.Cookie.Name,
+			Path:   details.Cookie.Path,
+			Domain: details.Cookie.Domain,
+		}
+	}
+
+	url := issueURL(issue, &details)
+	return debugger.ConsoleMessage{
+		Type:     "issue",
+		Time:     time.Now(),
+		Message:  issueSummary(issue, url),
+		URL:      url,
+		Source:   "audits",
+		Category: issue.Code,
+		Issue:    issue,
+	}
+}
+
+// issueURL picks the most relevant URL an issue points at
+func issueURL(issue *debugger.Issue, details *issueDetails) string {
+	for _, url := range []string{details.BlockedURL, details.InsecureURL, details.CookieURL, details.URL} {
+		if url != "" {
+			return url
+		}
+	}
+	if issue.AffectedRequest != nil {
+		return issue.AffectedRequest.URL
+	}
+	if details.SourceCodeLocation != nil {
+		return details.SourceCodeLocation.URL
+	}
+	return ""
+}
+
+func issueSummary(issue *debugger.Issue, url string) string {
+	var summary strings.Builder
+	summary.WriteString(strings.TrimSuffix(issue.Code, "Issue"))
+	summary.WriteString(" issue")
+	if issue.AffectedCookie != nil {
+		fmt.Fprintf(&summary, " for cookie %q", issue.AffectedCookie.Name)
+	}
+	if url != "" {
+		fmt.Fprintf(&summary, ": %s", url)
+	}
+	return summary.String()
+}
@@ -0,0 +1,38 @@
+package handlers
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestParseIssueKeepsDetails(t *testing.T) {
+	tests := []struct {
+		params  string
+		details string
+		message string
+	}{
+		{
+			`{"issue":{"code":"MixedContentIssue","issueId":"7","details":{"mixedContentIssueDetails":{"resolutionStatus":"MixedContentBlocked","insecureURL":"http://cdn.example.com/a.js","mainResourceURL":"https://example.com/","request":{"requestId":"r1","url":"http://cdn.example.com/a.js"},"newerField":[1,2]}}}}`,
+			`{"mixedContentIssueDetails":{"resolutionStatus":"MixedContentBlocked","insecureURL":"http://cdn.example.com/a.js","mainResourceURL":"https://example.com/","request":{"requestId":"r1","url":"http://cdn.example.com/a.js"},"newerField":[1,2]}}`,
+			"MixedContent issue: http://cdn.example.com/a.js",
+		},
+		{
+			// A kind of issue the vendored protocol does not know
+			`{"issue":{"code":"FutureIssue","details":{"futureIssueDetails":{"sourceCodeLocation":{"url":"https://example.com/app.js","lineNumber":3,"columnNumber":1},"reason":"Something new"}}}}`,
+			`{"futureIssueDetails":{"sourceCodeLocation":{"url":"https://example.com/app.js","lineNumber":3,"columnNumber":1},"reason":"Something new"}}`,
+			"Future issue: https://example.com/app.js",
+		},
+	}
+	for _, tt := range tests {
+		msg := parseIssue(json.RawMessage(tt.params))
+		if msg.Issue == nil {
+			t.Fatalf("parseIssue(%s) has no issue", tt.params)
+		}
+		if string(msg.Issue.Details) != tt.details {
+			t.Errorf("details = %s, want %s", msg.Issue.Details, tt.details)
+		}
+		if msg.Message != tt.message {
+			t.Errorf("message = %q, want %q", msg.Message, tt.message)
+		}
+	}
+}
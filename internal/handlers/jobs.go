@@ -0,0 +1,159 @@
+package handlers
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"sync"
+
+	"debugger-api/internal/debugger"
+	"debugger-api/internal/storage"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// Per-target progress states reported by a job
+const (
+	targetQueued     = "queued"
+	targetConnecting = "connecting"
+	targetCapturing  = "capturing"
+	targetDone       = "done"
+	targetFailed     = "failed"
+)
+
+// progressFunc receives the state changes of the targets of a capture. A
+// nil progressFunc ignores them.
+type progressFunc func(target *debugger.DebuggingTarget, state string, err error)
+
+func (p progressFunc) report(target *debugger.DebuggingTarget, state string, err error) {
+	if p != nil {
+		p(target, state, err)
+	}
+}
+
+var jobs *storage.JobStore
+
+// jobCancels holds the cancel function of every job running in this process
+var (
+	jobCancelsMu sync.Mutex
+	jobCancels   = make(map[string]context.CancelFunc)
+)
+
+// StartJob starts a capture in the background and returns its job right away
+func StartJob(c *fiber.Ctx) error {
+	var req debugger.DebugRequest
+	if err := c.BodyParser(&req); err != nil {
+		fmt.Printf("❌ Invalid request body: %v\n", err)
+		return c.Status(400).JSON(fiber.Map{"error": "Invalid request"})
+	}
+
+	session, err := newDebugSession(&req)
+	if err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	id, err := newJobID()
+	if err != nil {
+		return c.Status(500).JSON(fiber.Map{"error": "Failed to create job"})
+	}
+	if err := jobs.Create(&storage.Job{ID: id, Status: storage.JobQueued, Request: req}); err != nil {
+		fmt.Printf("❌ Failed to save job %s: %v\n", id, err)
+		return c.Status(500).JSON(fiber.Map{"error": "Failed to create job"})
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	jobCancelsMu.Lock()
+	jobCancels[id] = cancel
+	jobCancelsMu.Unlock()
+
+	fmt.Printf("🧵 Started job %s\n", id)
+	go runJob(ctx, id, session)
+
+	job, _ := jobs.Get(id)
+	return c.Status(202).JSON(job)
+}
+
+// GetJob reports the progress of a job and, once finished, its response
+func GetJob(c *fiber.Ctx) error {
+	job, ok := jobs.Get(c.Params("id"))
+	if !ok {
+		return c.Status(404).JSON(fiber.Map{"error": "Job not found"})
+	}
+	return c.JSON(job)
+}
+
+// CancelJob stops a running job. Its websockets are closed as the capture
+// unwinds; whatever was collected so far is kept in the job's response.
+func CancelJob(c *fiber.Ctx) error {
+	id := c.Params("id")
+	job, ok := jobs.Get(id)
+	if !ok {
+		return c.Status(404).JSON(fiber.Map{"error": "Job not found"})
+	}
+
+	jobCancelsMu.Lock()
+	cancel, running := jobCancels[id]
+	jobCancelsMu.Unlock()
+	if !running {
+		return c.Status(409).JSON(fiber.Map{"error": "Job is not running", "status": job.Status})
+	}
+
+	fmt.Printf("🛑 Cancelling job %s\n", id)
+	cancel()
+	return c.JSON(fiber.Map{"id": id, "status": "cancelling"})
+}
+
+func runJob(ctx context.Context, id string, session *debugSession) {
+	defer func() {
+		jobCancelsMu.Lock()
+		if cancel, ok := jobCancels[id]; ok {
+			cancel()
+			delete(jobCancels, id)
+		}
+		jobCancelsMu.Unlock()
+	}()
+
+	update := func(fn func(job *storage.Job)) {
+		if err := jobs.Update(id, fn); err != nil {
+			fmt.Printf("❌ Failed to update job %s: %v\n", id, err)
+		}
+	}
+
+	update(func(job *storage.Job) { job.Status = storage.JobRunning })
+
+	progress := func(target *debugger.DebuggingTarget, state string, err error) {
+		update(func(job *storage.Job) {
+			entry := storage.JobTarget{State: state, URL: target.URL}
+			if err != nil {
+				entry.Error = err.Error()
+			}
+			job.Targets[target.ID] = entry
+		})
+	}
+
+	response, err := session.run(ctx, progress)
+	if err := jobs.SaveResponse(id, &response); err != nil {
+		fmt.Printf("❌ Failed to save the response of job %s: %v\n", id, err)
+	}
+	update(func(job *storage.Job) {
+		switch {
+		case ctx.Err() != nil:
+			job.Status = storage.JobCancelled
+		case err != nil:
+			job.Status = storage.JobFailed
+			job.Error = err.Error()
+		default:
+			job.Status = storage.JobDone
+		}
+	})
+	fmt.Printf("🧵 Job %s finished\n", id)
+}
+
+func newJobID() (string, error) {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
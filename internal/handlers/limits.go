@@ -0,0 +1,330 @@
+package handlers
+
+import (
+	"encoding/json"
+	"hash/maphash"
+	"time"
+	"unicode/utf8"
+
+	"debugger-api/internal/debugger"
+	"debugger-api/internal/protocol"
+)
+
+// Limits listed in PageResults.TruncatedBy
+const (
+	limitMessages     = "maxMessages"
+	limitMessageBytes = "maxMessageBytes"
+	limitTotalBytes   = "maxTotalBytes"
+	limitEventQueue   = "eventQueue"
+)
+
+// captureLimits caps what a capture keeps of a target's messages
+type captureLimits struct {
+	messages     int // messages kept
+	messageBytes int // length of a message's text and of each argument string
+	totalBytes   int // size of all kept messages, as counted by messageSize
+}
+
+func requestCaptureLimits(req *debugger.DebugRequest) captureLimits {
+	limits := captureLimits{
+		messages:     cfg.MaxMessages,
+		messageBytes: cfg.MaxMessageBytes,
+		totalBytes:   cfg.MaxTotalBytes,
+	}
+	if req.Limits.MaxMessages > 0 {
+		limits.messages = req.Limits.MaxMessages
+	}
+	if req.Limits.MaxMessageBytes > 0 {
+		limits.messageBytes = req.Limits.MaxMessageBytes
+	}
+	if req.Limits.MaxTotalBytes > 0 {
+		limits.totalBytes = req.Limits.MaxTotalBytes
+	}
+	return limits
+}
+
+// repeatSeed hashes message text into repeat keys
+var repeatSeed = maphash.MakeSeed()
+
+// repeatKey identifies messages that are folded together when repeated.
+// The text is hashed before it is trimmed, so messages cut to the same
+// prefix are not folded and the key does not hold on to the full text.
+type repeatKey struct {
+	typ        string
+	text       uint64
+	url        string
+	target     *debugger.TargetInfo
+	navigation int
+}
+
+// floodGuard enforces a capture's limits. Identical messages are folded
+// into the first one, so a page logging the same line in a loop costs a
+// counter rather than a message per call.
+type floodGuard struct {
+	limits  captureLimits
+	bytes   int               // size of the kept messages
+	repeats map[repeatKey]int // kept message -> its index in targetCapture.messages
+	keys    map[int]repeatKey // index -> key of the kept messages that may be folded
+	dropped int               // messages not kept
+	hit     []string          // limits that truncated the capture, in the order they were hit
+
+	// droppedEvents is the subscription's count when the capture (or its
+	// current part) started
+	droppedEvents int
+}
+
+func newFloodGuard(limits captureLimits) floodGuard {
+	return floodGuard{
+		limits:  limits,
+		repeats: make(map[repeatKey]int),
+		keys:    make(map[int]repeatKey),
+	}
+}
+
+// repeatKeyOf returns the key of a message that may be folded. Group
+// messages open and close groups one by one, exceptions are revoked one by
+// one and tables keep their own data, so none of them are.
+func repeatKeyOf(msg *debugger.ConsoleMessage) (repeatKey, bool) {
+	if msg.GroupID != 0 || msg.APIType == "endGroup" || msg.APIType == "table" || msg.Exception != nil || msg.Table != nil {
+		return repeatKey{}, false
+	}
+	return repeatKey{
+		typ:        msg.Type,
+		text:       maphash.String(repeatSeed, msg.Message),
+		url:        msg.URL,
+		target:     msg.Target,
+		navigation: msg.Navigation,
+	}, true
+}
+
+// runtimeRepeatKey returns the key of a console call that may be folded.
+// Its text is hashed from the arguments as reported, previews included and
+// object IDs left out, so a repeat is found before anything is expanded.
+func runtimeRepeatKey(msg *debugger.ConsoleMessage, params *protocol.RuntimeConsoleAPICalledEvent) (repeatKey, bool) {
+	key, ok := repeatKeyOf(msg)
+	if !ok {
+		return key, false
+	}
+
+	var h maphash.Hash
+	h.SetSeed(repeatSeed)
+	for _, arg := range params.Args {
+		arg.ObjectID = ""
+		data, _ := json.Marshal(&arg)
+		h.Write(data)
+	}
+	key.text = h.Sum64()
+	return key, true
+}
+
+// repeat folds a message logged at the given time into the kept message
+// with the same key and returns its index
+func (g *floodGuard) repeat(messages []debugger.ConsoleMessage, key repeatKey, at time.Time) (int, bool) {
+	i, ok := g.repeats[key]
+	if !ok {
+		return 0, false
+	}
+
+	first := &messages[i]
+	if first.RepeatCount == 0 {
+		first.RepeatCount = 1
+	}
+	first.RepeatCount++
+	first.LastTime = &at
+	return i, true
+}
+
+// full reports whether no new message can fit anymore, counting the one
+// that did not as dropped. It is checked before a message is expanded.
+func (g *floodGuard) full(index int) bool {
+	if g.limits.messages > 0 && index >= g.limits.messages {
+		g.drop(limitMessages)
+		return true
+	}
+	if g.limits.totalBytes > 0 && g.bytes >= g.limits.totalBytes {
+		g.drop(limitTotalBytes)
+		return true
+	}
+	return false
+}
+
+// admit reports whether a new message fits in the limits
+func (g *floodGuard) admit(msg *debugger.ConsoleMessage, index int) bool {
+	if g.limits.messages > 0 && index >= g.limits.messages {
+		g.drop(limitMessages)
+		return false
+	}
+	if g.limits.totalBytes > 0 && g.bytes+messageSize(msg) > g.limits.totalBytes {
+		g.drop(limitTotalBytes)
+		return false
+	}
+	return true
+}
+
+// hold accounts for a message kept at index; key is only used when
+// foldable is set
+func (g *floodGuard) hold(msg *debugger.ConsoleMessage, key repeatKey, foldable bool, index int) {
+	g.bytes += messageSize(msg)
+	if foldable {
+		g.repeats[key] = index
+		g.keys[index] = key
+	}
+}
+
+// release undoes hold for a message that is replaced
+func (g *floodGuard) release(msg *debugger.ConsoleMessage, index int) {
+	g.bytes -= messageSize(msg)
+	if key, ok := g.keys[index]; ok {
+		delete(g.keys, index)
+		if g.repeats[key] == index {
+			delete(g.repeats, key)
+		}
+	}
+}
+
+func (g *floodGuard) drop(limit string) {
+	g.dropped++
+	g.note(limit)
+}
+
+// note records that a limit truncated the capture
+func (g *floodGuard) note(limit string) {
+	for _, hit := range g.hit {
+		if hit == limit {
+			return
+		}
+	}
+	g.hit = append(g.hit, limit)
+}
+
+// trim cuts a message's text and argument strings down to the per message
+// limit
+func (g *floodGuard) trim(msg *debugger.ConsoleMessage) {
+	limit := g.limits.messageBytes
+	if limit <= 0 {
+		return
+	}
+
+	cut := false
+	if len(msg.Message) > limit {
+		msg.Message = truncateText(msg.Message, limit)
+		msg.Spans = trimSpans(msg.Spans, limit)
+		cut = true
+	}
+	for _, arg := range msg.Args {
+		if trimValue(arg, limit) {
+			cut = true
+		}
+	}
+	if cut {
+		msg.Truncated = true
+		g.note(limitMessageBytes)
+	}
+}
+
+// mark fills in the truncation fields of a capture's results
+func (g *floodGuard) mark(results *debugger.PageResults, droppedEvents int) {
+	results.DroppedMessages = g.dropped
+	results.DroppedEvents = droppedEvents - g.droppedEvents
+	results.TruncatedBy = append([]string(nil), g.hit...)
+	if results.DroppedEvents > 0 {
+		results.TruncatedBy = append(results.TruncatedBy, limitEventQueue)
+	}
+	results.Truncated = len(results.TruncatedBy) > 0
+}
+
+// reset starts counting anew for the next part of a long running capture
+func (g *floodGuard) reset(droppedEvents int) {
+	*g = floodGuard{
+		limits:        g.limits,
+		repeats:       make(map[repeatKey]int),
+		keys:          make(map[int]repeatKey),
+		droppedEvents: droppedEvents,
+	}
+}
+
+// messageSize approximates the memory a message holds by the length of
+// its strings
+func messageSize(msg *debugger.ConsoleMessage) int {
+	size := len(msg.Message) + len(msg.URL)
+	for _, span := range msg.Spans {
+		size += len(span.Text) + len(span.Style)
+	}
+	for _, arg := range msg.Args {
+		size += valueSize(arg)
+	}
+	return size
+}
+
+func valueSize(v *debugger.RemoteValue) int {
+	if v == nil {
+		return 0
+	}
+	size := len(v.Description)
+	if s, ok := v.Value.(string); ok {
+		size += len(s)
+	}
+	for _, prop := range v.Properties {
+		size += len(prop.Name) + valueSize(prop.Value)
+	}
+	for _, item := range v.Items {
+		size += valueSize(item)
+	}
+	for _, entry := range v.Entries {
+		size += valueSize(entry.Key) + valueSize(entry.Value)
+	}
+	return size
+}
+
+// trimValue cuts the strings of a value tree down to limit and reports
+// whether any was
+func trimValue(v *debugger.RemoteValue, limit int) bool {
+	if v == nil {
+		return false
+	}
+	cut := false
+	if len(v.Description) > limit {
+		v.Description = truncateText(v.Description, limit)
+		cut = true
+	}
+	if s, ok := v.Value.(string); ok && len(s) > limit {
+		v.Value = truncateText(s, limit)
+		cut = true
+	}
+	for _, prop := range v.Properties {
+		cut = trimValue(prop.Value, limit) || cut
+	}
+	for _, item := range v.Items {
+		cut = trimValue(item, limit) || cut
+	}
+	for _, entry := range v.Entries {
+		cut = trimValue(entry.Key, limit) || cut
+		cut = trimValue(entry.Value, limit) || cut
+	}
+	return cut
+}
+
+// trimSpans keeps the spans covering the first limit bytes of the message
+func trimSpans(spans []debugger.StyledSpan, limit int) []debugger.StyledSpan {
+	for i := range spans {
+		if len(spans[i].Text) < limit {
+			limit -= len(spans[i].Text)
+			continue
+		}
+		spans[i].Text = truncateText(spans[i].Text, limit)
+		return spans[:i+1]
+	}
+	return spans
+}
+
+// truncateText cuts s to at most limit bytes without splitting a rune and
+// marks the cut with an ellipsis
+func truncateText(s string, limit int) string {
+	if len(s) <= limit {
+		return s
+	}
+	for limit > 0 && !utf8.RuneStart(s[limit]) {
+		limit--
+	}
+	return s[:limit] + "…"
+}
@@ -0,0 +1,263 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"debugger-api/internal/debugger"
+	"debugger-api/internal/protocol"
+)
+
+const appURL = "http://localhost:3000/app.js"
+
+// fakePage answers Runtime.getProperties from canned replies keyed by
+// object ID and counts the calls
+type fakePage struct {
+	replies map[string]string
+	calls   int
+}
+
+func (p *fakePage) Call(ctx context.Context, method string, params interface{}, result interface{}) error {
+	if method != protocol.CommandRuntimeGetProperties {
+		return fmt.Errorf("unexpected %s", method)
+	}
+	p.calls++
+	id := params.(protocol.RuntimeGetPropertiesParams).ObjectID
+	reply, ok := p.replies[id]
+	if !ok {
+		return fmt.Errorf("no object %s", id)
+	}
+	return json.Unmarshal([]byte(reply), result)
+}
+
+func newTestCapture(limits captureLimits, page debugger.Caller) *targetCapture {
+	return &targetCapture{
+		target:  &debugger.DebuggingTarget{ID: "t1", URL: appURL},
+		page:    page,
+		network: newNetworkTracker(),
+		stop:    newStopState(time.Now()),
+		values:  valueLimits{depth: 2, properties: 10},
+		flood:   newFloodGuard(limits),
+	}
+}
+
+// consoleCopy is the Console.messageAdded report of a console call
+func consoleCopy(text string) *debugger.CDPEvent {
+	quoted, _ := json.Marshal(text)
+	return &debugger.CDPEvent{
+		Method: protocol.EventConsoleMessageAdded,
+		Params: json.RawMessage(`{"message":{"source":"console-api","level":"log","text":` + string(quoted) +
+			`,"url":"` + appURL + `","line":5,"column":9}}`),
+	}
+}
+
+// consoleCall is the Runtime.consoleAPICalled report of console.log(args)
+func consoleCall(args string) *debugger.CDPEvent {
+	return &debugger.CDPEvent{
+		Method: protocol.EventRuntimeConsoleAPICalled,
+		Params: json.RawMessage(`{"type":"log","executionContextId":1,"timestamp":1700000000000,"args":` + args +
+			`,"stackTrace":{"callFrames":[{"functionName":"","scriptId":"7","url":"` + appURL + `","lineNumber":4,"columnNumber":8}]}}`),
+	}
+}
+
+func logString(s string) *debugger.CDPEvent {
+	quoted, _ := json.Marshal(s)
+	return consoleCall(`[{"type":"string","value":` + string(quoted) + `}]`)
+}
+
+// logObject logs {a: a}; id tells apart objects logged by separate calls
+func logObject(a, id int) *debugger.CDPEvent {
+	return consoleCall(fmt.Sprintf(`[{"type":"object","className":"Object","description":"Object","objectId":"%d/%d",`+
+		`"preview":{"type":"object","description":"Object","overflow":false,"properties":[{"name":"a","type":"number","value":"%d"}]}}]`, a, id, a))
+}
+
+// objectPage knows the objects logObject logs
+func objectPage() *fakePage {
+	page := &fakePage{replies: make(map[string]string)}
+	for a := 1; a <= 4; a++ {
+		for id := 1; id <= 4; id++ {
+			page.replies[fmt.Sprintf("%d/%d", a, id)] = fmt.Sprintf(
+				`{"result":[{"name":"a","value":{"type":"number","value":%d,"description":"%d"},"configurable":true,"enumerable":true,"isOwn":true}]}`, a, a)
+		}
+	}
+	return page
+}
+
+type keptMessage struct {
+	text    string
+	repeats int
+	source  string
+}
+
+func TestCaptureLimits(t *testing.T) {
+	tests := []struct {
+		name        string
+		limits      captureLimits
+		events      []*debugger.CDPEvent
+		want        []keptMessage
+		dropped     int
+		truncatedBy []string
+		calls       int
+	}{
+		{
+			name:   "repeats are folded",
+			events: []*debugger.CDPEvent{logString("hello"), logString("hello"), logString("hello")},
+			want:   []keptMessage{{"hello", 3, "runtime"}},
+		},
+		{
+			name:   "the Runtime copy replaces the Console copy",
+			events: []*debugger.CDPEvent{consoleCopy("hello"), logString("hello")},
+			want:   []keptMessage{{"hello", 0, "runtime"}},
+		},
+		{
+			name:   "a Console copy after the Runtime copy is skipped",
+			events: []*debugger.CDPEvent{logString("hello"), consoleCopy("hello")},
+			want:   []keptMessage{{"hello", 0, "runtime"}},
+		},
+		{
+			name: "a Runtime copy repeating an earlier message is taken back",
+			events: []*debugger.CDPEvent{
+				consoleCopy("hello"), logString("hello"),
+				consoleCopy("hello"), logString("hello"),
+				consoleCopy("hello"), logString("hello"),
+			},
+			want: []keptMessage{{"hello", 3, "runtime"}},
+		},
+		{
+			name:   "copies replaced out of order keep their slots",
+			events: []*debugger.CDPEvent{consoleCopy("a"), consoleCopy("b"), logString("a"), logString("b")},
+			want:   []keptMessage{{"a", 0, "runtime"}, {"b", 0, "runtime"}},
+		},
+		{
+			name:   "repeated objects are expanded once",
+			events: []*debugger.CDPEvent{logObject(1, 1), logObject(1, 2), logObject(1, 3)},
+			want:   []keptMessage{{"{a: 1}", 3, "runtime"}},
+			calls:  1,
+		},
+		{
+			name:        "calls past the message cap are not expanded",
+			limits:      captureLimits{messages: 2},
+			events:      []*debugger.CDPEvent{logObject(1, 1), logObject(2, 1), logObject(3, 1), logObject(4, 1)},
+			want:        []keptMessage{{"{a: 1}", 0, "runtime"}, {"{a: 2}", 0, "runtime"}},
+			dropped:     2,
+			truncatedBy: []string{limitMessages},
+			calls:       2,
+		},
+		{
+			name:        "a dropped call is counted once",
+			limits:      captureLimits{messages: 1},
+			events:      []*debugger.CDPEvent{consoleCopy("a"), logString("a"), consoleCopy("b"), logString("b"), logString("c"), consoleCopy("c")},
+			want:        []keptMessage{{"a", 0, "runtime"}},
+			dropped:     2,
+			truncatedBy: []string{limitMessages},
+		},
+		{
+			name:        "repeats still fold at the message cap",
+			limits:      captureLimits{messages: 1},
+			events:      []*debugger.CDPEvent{logString("a"), logString("b"), logString("a")},
+			want:        []keptMessage{{"a", 2, "runtime"}},
+			dropped:     1,
+			truncatedBy: []string{limitMessages},
+		},
+		{
+			// Each message is its text, its argument and the URL: 50 bytes
+			name:        "the byte cap drops what does not fit",
+			limits:      captureLimits{totalBytes: 120},
+			events:      []*debugger.CDPEvent{logString("message one"), logString("message two"), logString("message six"), logString("1")},
+			want:        []keptMessage{{"message one", 0, "runtime"}, {"message two", 0, "runtime"}},
+			dropped:     2,
+			truncatedBy: []string{limitTotalBytes},
+		},
+		{
+			name:        "long messages are cut",
+			limits:      captureLimits{messageBytes: 10},
+			events:      []*debugger.CDPEvent{logString("0123456789abcdef")},
+			want:        []keptMessage{{"0123456789…", 0, "runtime"}},
+			truncatedBy: []string{limitMessageBytes},
+		},
+		{
+			name:        "cut messages are folded on their full text",
+			limits:      captureLimits{messageBytes: 10},
+			events:      []*debugger.CDPEvent{logString("0123456789abc"), logString("0123456789xyz"), logString("0123456789abc")},
+			want:        []keptMessage{{"0123456789…", 2, "runtime"}, {"0123456789…", 0, "runtime"}},
+			truncatedBy: []string{limitMessageBytes},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			page := objectPage()
+			tc := newTestCapture(tt.limits, page)
+			for _, event := range tt.events {
+				tc.handleEvent(context.Background(), event)
+			}
+
+			var got []keptMessage
+			for _, msg := range tc.messages {
+				got = append(got, keptMessage{msg.Message, msg.RepeatCount, msg.Source})
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("messages = %+v, want %+v", got, tt.want)
+			}
+
+			var results debugger.PageResults
+			tc.flood.mark(&results, 0)
+			if results.DroppedMessages != tt.dropped {
+				t.Errorf("DroppedMessages = %d, want %d", results.DroppedMessages, tt.dropped)
+			}
+			if !reflect.DeepEqual(results.TruncatedBy, tt.truncatedBy) {
+				t.Errorf("TruncatedBy = %v, want %v", results.TruncatedBy, tt.truncatedBy)
+			}
+			if results.Truncated != (len(tt.truncatedBy) > 0) {
+				t.Errorf("Truncated = %v", results.Truncated)
+			}
+			if page.calls != tt.calls {
+				t.Errorf("%d Runtime.getProperties calls, want %d", page.calls, tt.calls)
+			}
+		})
+	}
+}
+
+func TestTrimSpans(t *testing.T) {
+	spans := func() []debugger.StyledSpan {
+		return []debugger.StyledSpan{{Text: "Server ", Style: "color: red"}, {Text: "The names are"}}
+	}
+	tests := []struct {
+		limit int
+		want  []debugger.StyledSpan
+	}{
+		{100, spans()},
+		{10, []debugger.StyledSpan{{Text: "Server ", Style: "color: red"}, {Text: "The…"}}},
+		{7, []debugger.StyledSpan{{Text: "Server ", Style: "color: red"}}},
+		{3, []debugger.StyledSpan{{Text: "Ser…", Style: "color: red"}}},
+	}
+	for _, tt := range tests {
+		if got := trimSpans(spans(), tt.limit); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("trimSpans(%d) = %+v, want %+v", tt.limit, got, tt.want)
+		}
+	}
+}
+
+func TestTruncateText(t *testing.T) {
+	tests := []struct {
+		s     string
+		limit int
+		want  string
+	}{
+		{"short", 10, "short"},
+		{"0123456789", 4, "0123…"},
+		// "é" is two bytes and is not split
+		{"café au lait", 4, "caf…"},
+		{strings.Repeat("日", 3), 4, "日…"},
+	}
+	for _, tt := range tests {
+		if got := truncateText(tt.s, tt.limit); got != tt.want {
+			t.Errorf("truncateText(%q, %d) = %q, want %q", tt.s, tt.limit, got, tt.want)
+		}
+	}
+}
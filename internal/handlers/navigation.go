@@ -0,0 +1,74 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"debugger-api/internal/debugger"
+	"debugger-api/internal/protocol"
+)
+
+// navigateTarget reloads or navigates the target as the request asks, or
+// sends a tab radar opened itself to its URL. It is called after all
+// domains are enabled so nothing the page logs while loading is missed.
+func navigateTarget(ctx context.Context, client debugger.Caller, target *debugger.DebuggingTarget, req *debugger.DebugRequest) error {
+	if url := navigateURL(target, req); url != "" {
+		var reply protocol.PageNavigateReturns
+		params := protocol.PageNavigateParams{URL: url}
+		if err := client.Call(ctx, protocol.CommandPageNavigate, params, &reply); err != nil {
+			return fmt.Errorf("failed to navigate to %s: %v", url, err)
+		}
+		if reply.ErrorText != "" {
+			return fmt.Errorf("failed to navigate to %s: %s", url, reply.ErrorText)
+		}
+		return nil
+	}
+
+	if req.Reload {
+		params := protocol.PageReloadParams{IgnoreCache: req.BypassCache}
+		if err := client.Call(ctx, protocol.CommandPageReload, params, nil); err != nil {
+			return fmt.Errorf("failed to reload: %v", err)
+		}
+	}
+	return nil
+}
+
+func navigateURL(target *debugger.DebuggingTarget, req *debugger.DebugRequest) string {
+	if req.NavigateURL != "" {
+		return req.NavigateURL
+	}
+	return target.OpenURL
+}
+
+// willNavigate reports whether the target is reloaded or navigated
+func willNavigate(target *debugger.DebuggingTarget, req *debugger.DebugRequest) bool {
+	return req.Reload || navigateURL(target, req) != ""
+}
+
+// frameNavigated starts a new navigation when the main frame commits one.
+// Child frame navigations belong to the page's current navigation.
+func (tc *targetCapture) frameNavigated(params *protocol.PageFrameNavigatedEvent) {
+	frame := &params.Frame
+	if frame.ParentID != "" {
+		return
+	}
+
+	navigation := debugger.Navigation{
+		Index:    len(tc.navigations) + 1,
+		URL:      frame.URL,
+		FrameID:  frame.ID,
+		LoaderID: frame.LoaderID,
+		Time:     time.Now(),
+	}
+	tc.navigations = append(tc.navigations, navigation)
+	tc.network.navigation = navigation.Index
+
+	// A new document has its own load event to wait for
+	tc.stop.loadedAt = time.Time{}
+}
+
+// navigation returns the index of the navigation currently being captured
+func (tc *targetCapture) navigation() int {
+	return len(tc.navigations)
+}
@@ -0,0 +1,164 @@
+package handlers
+
+import (
+	"time"
+
+	"debugger-api/internal/debugger"
+	"debugger-api/internal/protocol"
+)
+
+// pendingRequest is what we remember about a request until it completes
+type pendingRequest struct {
+	method    string
+	url       string
+	wallTime  time.Time
+	timestamp float64 // monotonic seconds, as used by loadingFailed/responseReceived
+	loaderID  string
+	initiator *debugger.Initiator
+}
+
+// networkTracker follows requests through the Network domain and keeps the
+// ones that failed or came back with an error status.
+type networkTracker struct {
+	// navigation is the index of the navigation new failures belong to
+	navigation int
+
+	pending  map[string]*pendingRequest
+	failed   map[string]int // requestId -> index into failures
+	failures []debugger.NetworkFailure
+	loaders  []string // loaderId of each failure
+}
+
+func newNetworkTracker() *networkTracker {
+	return &networkTracker{
+		pending:  make(map[string]*pendingRequest),
+		failed:   make(map[string]int),
+		failures: make([]debugger.NetworkFailure, 0),
+	}
+}
+
+// inFlight reports how many requests are still waiting for completion
+func (n *networkTracker) inFlight() int {
+	return len(n.pending)
+}
+
+func (n *networkTracker) requestWillBeSent(params *protocol.NetworkRequestWillBeSentEvent) {
+	if params.RequestID == "" {
+		return
+	}
+
+	pending := &pendingRequest{
+		method:    params.Request.Method,
+		url:       params.Request.URL,
+		wallTime:  time.Now(),
+		timestamp: params.Timestamp,
+		loaderID:  params.LoaderID,
+		initiator: parseInitiator(&params.Initiator),
+	}
+	if params.WallTime > 0 {
+		pending.wallTime = time.UnixMilli(int64(params.WallTime * 1000))
+	}
+
+	// Redirects reuse the request id; the latest hop is the one that counts
+	n.pending[params.RequestID] = pending
+}
+
+// responseReceived records error responses and returns their failure, or
+// nil when the response was fine
+func (n *networkTracker) responseReceived(params *protocol.NetworkResponseReceivedEvent) *debugger.NetworkFailure {
+	response := &params.Response
+	if response.Status < 400 {
+		return nil
+	}
+
+	failure := n.failure(params.RequestID, params.Type, params.LoaderID, params.Timestamp)
+	failure.Status = response.Status
+	failure.StatusText = response.StatusText
+	if response.URL != "" {
+		failure.URL = response.URL
+	}
+	return failure
+}
+
+func (n *networkTracker) loadingFailed(params *protocol.NetworkLoadingFailedEvent) *debugger.NetworkFailure {
+	failure := n.failure(params.RequestID, params.Type, "", params.Timestamp)
+	failure.ErrorText = params.ErrorText
+	failure.Canceled = params.Canceled
+	failure.BlockedReason = params.BlockedReason
+	if failure.BlockedReason == "" && params.CorsErrorStatus != nil {
+		failure.BlockedReason = params.CorsErrorStatus.CorsError
+	}
+
+	delete(n.pending, params.RequestID)
+	return failure
+}
+
+func (n *networkTracker) loadingFinished(params *protocol.NetworkLoadingFinishedEvent) {
+	delete(n.pending, params.RequestID)
+}
+
+// failure returns the failure record for a request, creating it from the
+// pending request on first use. timestamp is when the event reporting the
+// failure happened.
+func (n *networkTracker) failure(requestID, resourceType, loaderID string, timestamp float64) *debugger.NetworkFailure {
+	if i, ok := n.failed[requestID]; ok {
+		return &n.failures[i]
+	}
+
+	failure := debugger.NetworkFailure{
+		RequestID:    requestID,
+		ResourceType: resourceType,
+		Time:         time.Now(),
+		Navigation:   n.navigation,
+	}
+
+	if pending, ok := n.pending[requestID]; ok {
+		loaderID = pending.loaderID
+		failure.Method = pending.method
+		failure.URL = pending.url
+		failure.Time = pending.wallTime
+		failure.Initiator = pending.initiator
+		if timestamp > 0 && pending.timestamp > 0 {
+			failure.Duration = (timestamp - pending.timestamp) * 1000
+		}
+	}
+
+	n.failed[requestID] = len(n.failures)
+	n.failures = append(n.failures, failure)
+	n.loaders = append(n.loaders, loaderID)
+	return &n.failures[len(n.failures)-1]
+}
+
+// reset forgets the recorded failures; requests still in flight are kept
+func (n *networkTracker) reset() {
+	n.failed = make(map[string]int)
+	n.failures = make([]debugger.NetworkFailure, 0)
+	n.loaders = nil
+}
+
+// assignNavigations moves failures to the navigation that loaded them. A
+// failed main document is reported before its navigation commits, so the
+// loaderId is the only reliable link.
+func (n *networkTracker) assignNavigations(navigations []debugger.Navigation) {
+	for i, loaderID := range n.loaders {
+		if loaderID == "" {
+			continue
+		}
+		for _, navigation := range navigations {
+			if navigation.LoaderID == loaderID {
+				n.failures[i].Navigation = navigation.Index
+				break
+			}
+		}
+	}
+}
+
+func parseInitiator(initiator *protocol.NetworkInitiator) *debugger.Initiator {
+	return &debugger.Initiator{
+		Type:         initiator.Type,
+		URL:          initiator.URL,
+		LineNumber:   int(initiator.LineNumber),
+		ColumnNumber: int(initiator.ColumnNumber),
+		StackTrace:   parseStackTrace(initiator.Stack),
+	}
+}
@@ -0,0 +1,101 @@
+package handlers
+
+import (
+	"context"
+
+	"debugger-api/internal/debugger"
+	"debugger-api/internal/protocol"
+	"debugger-api/internal/sourcemap"
+)
+
+// sourceMaps is shared by all captures so each map is parsed only once
+var sourceMaps = sourcemap.NewCache()
+
+// addScript records a Debugger.scriptParsed event with the resolver
+func addScript(resolver *sourcemap.Resolver, params *protocol.DebuggerScriptParsedEvent) {
+	if params.ScriptID == "" {
+		return
+	}
+	resolver.AddScript(sourcemap.Script{
+		ID:           params.ScriptID,
+		URL:          params.URL,
+		SourceMapURL: params.SourceMapURL,
+		Hash:         params.Hash,
+	})
+}
+
+// resolveSourceMaps rewrites every stack frame that has a source map to its
+// original location, keeping the bundled position in Generated.
+func resolveSourceMaps(ctx context.Context, resolver *sourcemap.Resolver, messages []debugger.ConsoleMessage, failures []debugger.NetworkFailure) {
+	for i := range messages {
+		// Script IDs are per isolate, so frames from workers and iframes
+		// can only be matched to scripts by URL
+		byURL := messages[i].Target != nil
+		resolveStackTrace(ctx, resolver, messages[i].StackTrace, byURL)
+		for _, arg := range messages[i].Args {
+			resolveErrorStacks(ctx, resolver, arg)
+		}
+	}
+	for i := range failures {
+		if failures[i].Initiator != nil {
+			resolveStackTrace(ctx, resolver, failures[i].Initiator.StackTrace, false)
+		}
+	}
+}
+
+// resolveErrorStacks resolves the stacks of the Error objects in a value
+// tree. They come from stack strings, which only carry URLs.
+func resolveErrorStacks(ctx context.Context, resolver *sourcemap.Resolver, v *debugger.RemoteValue) {
+	if v == nil {
+		return
+	}
+	for record := v.Error; record != nil; record = record.Cause {
+		resolveStackTrace(ctx, resolver, record.Stack, true)
+	}
+	for _, prop := range v.Properties {
+		resolveErrorStacks(ctx, resolver, prop.Value)
+	}
+	for _, item := range v.Items {
+		resolveErrorStacks(ctx, resolver, item)
+	}
+	for _, entry := range v.Entries {
+		resolveErrorStacks(ctx, resolver, entry.Key)
+		resolveErrorStacks(ctx, resolver, entry.Value)
+	}
+}
+
+func resolveStackTrace(ctx context.Context, resolver *sourcemap.Resolver, trace *debugger.StackTrace, byURL bool) {
+	for ; trace != nil; trace = trace.Parent {
+		for i := range trace.CallFrames {
+			resolveFrame(ctx, resolver, &trace.CallFrames[i], byURL)
+		}
+	}
+}
+
+func resolveFrame(ctx context.Context, resolver *sourcemap.Resolver, frame *debugger.CallFrame, byURL bool) {
+	if frame.Generated != nil {
+		return
+	}
+
+	scriptID := frame.ScriptID
+	if byURL {
+		scriptID = ""
+	}
+	pos, ok := resolver.Resolve(ctx, scriptID, frame.URL, frame.LineNumber, frame.ColumnNumber)
+	if !ok {
+		return
+	}
+
+	frame.Generated = &debugger.GeneratedPosition{
+		FunctionName: frame.FunctionName,
+		URL:          frame.URL,
+		LineNumber:   frame.LineNumber,
+		ColumnNumber: frame.ColumnNumber,
+	}
+	frame.URL = pos.Source
+	frame.LineNumber = pos.Line
+	frame.ColumnNumber = pos.Column
+	if pos.Name != "" {
+		frame.FunctionName = pos.Name
+	}
+}
@@ -0,0 +1,38 @@
+package handlers
+
+import (
+	"debugger-api/internal/debugger"
+	"debugger-api/internal/protocol"
+)
+
+// maxAsyncDepth bounds how many async parent stacks are kept per message
+const maxAsyncDepth = 8
+
+// parseStackTrace converts a Runtime.StackTrace into its structured form,
+// following async parents up to maxAsyncDepth.
+func parseStackTrace(stackTrace *protocol.RuntimeStackTrace) *debugger.StackTrace {
+	return parseStackTraceDepth(stackTrace, 0)
+}
+
+func parseStackTraceDepth(stackTrace *protocol.RuntimeStackTrace, depth int) *debugger.StackTrace {
+	if stackTrace == nil || depth > maxAsyncDepth {
+		return nil
+	}
+
+	trace := &debugger.StackTrace{
+		Description: stackTrace.Description,
+		CallFrames:  make([]debugger.CallFrame, 0, len(stackTrace.CallFrames)),
+	}
+	for _, frame := range stackTrace.CallFrames {
+		trace.CallFrames = append(trace.CallFrames, debugger.CallFrame{
+			FunctionName: frame.FunctionName,
+			URL:          frame.URL,
+			ScriptID:     frame.ScriptID,
+			LineNumber:   frame.LineNumber,
+			ColumnNumber: frame.ColumnNumber,
+		})
+	}
+
+	trace.Parent = parseStackTraceDepth(stackTrace.Parent, depth+1)
+	return trace
+}
@@ -0,0 +1,104 @@
+package handlers
+
+import (
+	"context"
+	"time"
+
+	"debugger-api/internal/debugger"
+	"debugger-api/internal/protocol"
+)
+
+// defaultCaptureDuration caps a capture when the request sets no maximum
+const defaultCaptureDuration = 30 * time.Second
+
+// stopCheckInterval is how often the time based stop conditions are checked
+const stopCheckInterval = 100 * time.Millisecond
+
+// Reasons a capture ended, reported in PageResults.StopReason
+const (
+	stopMaxDuration  = "maxDuration"
+	stopLoadQuiet    = "loadQuiet"
+	stopNetworkIdle  = "networkIdle"
+	stopFirstError   = "firstError"
+	stopMaxMessages  = "maxMessages"
+	stopDisconnected = "disconnected"
+	stopCancelled    = "cancelled"
+	stopDetached     = "detached"
+	stopClosed       = "closed"
+	stopCrashed      = "crashed"
+)
+
+// stopState tracks what the stop conditions are evaluated against
+type stopState struct {
+	loadedAt     time.Time // zero until the load event fired
+	lastActivity time.Time
+	idleSince    time.Time // zero while requests are in flight
+	errors       int
+	messages     int // every message recorded, repeats and dropped ones included
+}
+
+func newStopState(now time.Time) stopState {
+	return stopState{lastActivity: now, idleSince: now}
+}
+
+// maxDuration returns the hard limit for a capture
+func maxDuration(stop debugger.StopConditions) time.Duration {
+	if stop.MaxDurationMs > 0 {
+		return time.Duration(stop.MaxDurationMs) * time.Millisecond
+	}
+	return defaultCaptureDuration
+}
+
+// checkStop reports the first stop condition that is met, or ""
+func (tc *targetCapture) checkStop(stop debugger.StopConditions, now time.Time) string {
+	if stop.FirstError && tc.stop.errors > 0 {
+		return stopFirstError
+	}
+	if stop.MaxMessages > 0 && tc.stop.messages >= stop.MaxMessages {
+		return stopMaxMessages
+	}
+
+	if stop.LoadQuietMs > 0 && !tc.stop.loadedAt.IsZero() {
+		quiet := time.Duration(stop.LoadQuietMs) * time.Millisecond
+		since := tc.stop.lastActivity
+		if tc.stop.loadedAt.After(since) {
+			since = tc.stop.loadedAt
+		}
+		if now.Sub(since) >= quiet {
+			return stopLoadQuiet
+		}
+	}
+
+	if stop.NetworkIdleMs > 0 && !tc.stop.idleSince.IsZero() {
+		if now.Sub(tc.stop.idleSince) >= time.Duration(stop.NetworkIdleMs)*time.Millisecond {
+			return stopNetworkIdle
+		}
+	}
+	return ""
+}
+
+// noteNetwork updates the idle timer after a Network event
+func (tc *targetCapture) noteNetwork(at time.Time) {
+	tc.stop.lastActivity = at
+	if tc.network.inFlight() > 0 {
+		tc.stop.idleSince = time.Time{}
+	} else if tc.stop.idleSince.IsZero() {
+		tc.stop.idleSince = at
+	}
+}
+
+// markLoadedIfComplete treats an already loaded document as if its load
+// event fired at attach time, since we will never see that event
+func markLoadedIfComplete(ctx context.Context, client debugger.Caller, state *stopState) {
+	var reply protocol.RuntimeEvaluateReturns
+	params := protocol.RuntimeEvaluateParams{
+		Expression:    "document.readyState",
+		ReturnByValue: true,
+	}
+	if err := client.Call(ctx, protocol.CommandRuntimeEvaluate, params, &reply); err != nil {
+		return
+	}
+	if reply.Result.Value == "complete" {
+		state.loadedAt = time.Now()
+	}
+}
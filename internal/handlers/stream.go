@@ -0,0 +1,228 @@
+package handlers
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"debugger-api/internal/debugger"
+
+	"github.com/gofiber/contrib/websocket"
+	"github.com/gofiber/fiber/v2"
+)
+
+// streamBuffer is how many events a slow subscriber may fall behind before
+// new events are dropped for it
+const streamBuffer = 256
+
+// streamKeepAlive is how often an idle SSE stream sends a comment so proxies
+// keep the connection open
+const streamKeepAlive = 15 * time.Second
+
+// streamFilter selects the events a subscriber receives. Empty fields match
+// everything.
+type streamFilter struct {
+	levels map[string]bool // message types; failed requests have level "network"
+	text   string          // lower-cased substring of the message text or request URL
+	target string          // substring of the target ID or page URL
+}
+
+// newStreamFilter reads the level, text and target query parameters.
+// level takes a comma separated list.
+func newStreamFilter(query func(key string, defaultValue ...string) string) streamFilter {
+	filter := streamFilter{
+		text:   strings.ToLower(query("text")),
+		target: query("target"),
+	}
+	for _, level := range strings.Split(query("level"), ",") {
+		if level = strings.TrimSpace(level); level != "" {
+			if filter.levels == nil {
+				filter.levels = make(map[string]bool)
+			}
+			filter.levels[level] = true
+		}
+	}
+	return filter
+}
+
+func (f streamFilter) matches(event *debugger.StreamEvent) bool {
+	if f.target != "" && !strings.Contains(event.TargetID, f.target) && !strings.Contains(event.URL, f.target) {
+		return false
+	}
+
+	level, text := "network", ""
+	if event.Message != nil {
+		level, text = event.Message.Type, event.Message.Message
+	} else if event.Network != nil {
+		text = event.Network.URL + " " + event.Network.ErrorText
+	}
+
+	if f.levels != nil && !f.levels[level] {
+		return false
+	}
+	return f.text == "" || strings.Contains(strings.ToLower(text), f.text)
+}
+
+type streamSubscriber struct {
+	filter streamFilter
+	events chan []byte
+}
+
+// broadcaster fans captured events out to live stream subscribers. Events
+// are encoded once, in the capture goroutine, so subscribers never share
+// state with a capture that is still running.
+type broadcaster struct {
+	mu          sync.RWMutex
+	subscribers map[*streamSubscriber]struct{}
+}
+
+var streams = &broadcaster{subscribers: make(map[*streamSubscriber]struct{})}
+
+func (b *broadcaster) subscribe(filter streamFilter) *streamSubscriber {
+	sub := &streamSubscriber{filter: filter, events: make(chan []byte, streamBuffer)}
+	b.mu.Lock()
+	b.subscribers[sub] = struct{}{}
+	b.mu.Unlock()
+	return sub
+}
+
+func (b *broadcaster) unsubscribe(sub *streamSubscriber) {
+	b.mu.Lock()
+	delete(b.subscribers, sub)
+	b.mu.Unlock()
+}
+
+func (b *broadcaster) publish(event *debugger.StreamEvent) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	var data []byte
+	for sub := range b.subscribers {
+		if !sub.filter.matches(event) {
+			continue
+		}
+		if data == nil {
+			var err error
+			if data, err = json.Marshal(event); err != nil {
+				fmt.Printf("❌ Failed to encode stream event: %v\n", err)
+				return
+			}
+		}
+		// A subscriber that cannot keep up misses events rather than
+		// stalling the capture
+		select {
+		case sub.events <- data:
+		default:
+		}
+	}
+}
+
+func (b *broadcaster) active() bool {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return len(b.subscribers) > 0
+}
+
+// publishMessage streams a message the capture just recorded
+func (tc *targetCapture) publishMessage(msg *debugger.ConsoleMessage) {
+	if !streams.active() {
+		return
+	}
+	streams.publish(&debugger.StreamEvent{
+		Kind:     "console",
+		TargetID: tc.target.ID,
+		URL:      tc.target.URL,
+		Message:  msg,
+	})
+}
+
+// publishFailure streams a failed request
+func (tc *targetCapture) publishFailure(failure *debugger.NetworkFailure) {
+	if !streams.active() {
+		return
+	}
+	streams.publish(&debugger.StreamEvent{
+		Kind:     "network",
+		TargetID: tc.target.ID,
+		URL:      tc.target.URL,
+		Network:  failure,
+	})
+}
+
+// StreamEvents pushes captured events to the client as Server-Sent Events
+func StreamEvents(c *fiber.Ctx) error {
+	sub := streams.subscribe(newStreamFilter(c.Query))
+	fmt.Println("📡 Stream subscriber connected")
+
+	c.Set("Content-Type", "text/event-stream")
+	c.Set("Cache-Control", "no-cache")
+	c.Set("Connection", "keep-alive")
+
+	c.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		defer streams.unsubscribe(sub)
+		keepAlive := time.NewTicker(streamKeepAlive)
+		defer keepAlive.Stop()
+
+		// Send the headers right away instead of with the first event
+		fmt.Fprint(w, ": connected\n\n")
+		if err := w.Flush(); err != nil {
+			return
+		}
+
+		for {
+			select {
+			case data := <-sub.events:
+				fmt.Fprintf(w, "data: %s\n\n", data)
+			case <-keepAlive.C:
+				fmt.Fprint(w, ": keep-alive\n\n")
+			}
+			// Flush fails once the client went away
+			if err := w.Flush(); err != nil {
+				fmt.Println("📡 Stream subscriber disconnected")
+				return
+			}
+		}
+	})
+	return nil
+}
+
+// StreamWebSocket pushes captured events to the client over a websocket,
+// one JSON event per text message
+func StreamWebSocket(c *fiber.Ctx) error {
+	if !websocket.IsWebSocketUpgrade(c) {
+		return c.Status(426).JSON(fiber.Map{"error": "Websocket upgrade required"})
+	}
+	return streamWebSocket(c)
+}
+
+var streamWebSocket = websocket.New(func(conn *websocket.Conn) {
+	sub := streams.subscribe(newStreamFilter(conn.Query))
+	defer streams.unsubscribe(sub)
+	fmt.Println("📡 Websocket stream subscriber connected")
+
+	// Nothing is expected from the client; reading notices when it leaves
+	closed := make(chan struct{})
+	go func() {
+		defer close(closed)
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}()
+
+	for {
+		select {
+		case data := <-sub.events:
+			if err := conn.WriteMessage(websocket.TextMessage, data); err != nil {
+				return
+			}
+		case <-closed:
+			fmt.Println("📡 Websocket stream subscriber disconnected")
+			return
+		}
+	}
+})
@@ -0,0 +1,174 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"debugger-api/internal/config"
+	"debugger-api/internal/debugger"
+)
+
+// browserEndpoint is one Chrome instance a request captures from. browser
+// is nil until selectTargets connected to it.
+type browserEndpoint struct {
+	name    string
+	url     string
+	browser debugger.Browser
+}
+
+// selectedTarget is a tab picked for capture, with the browser it lives in
+// and the keys of the matchers that selected it
+type selectedTarget struct {
+	target   *debugger.DebuggingTarget
+	endpoint *browserEndpoint
+	matched  []string
+}
+
+// requestEndpoints resolves which browsers a request captures from
+func requestEndpoints(req *debugger.DebugRequest) ([]*browserEndpoint, error) {
+	var endpoints []config.Endpoint
+	switch {
+	case req.AllEndpoints:
+		endpoints = cfg.Endpoints
+	case req.Endpoint == "":
+		endpoints = cfg.Endpoints[:1]
+	default:
+		endpoint, ok := cfg.Endpoint(req.Endpoint)
+		if !ok {
+			if !strings.Contains(req.Endpoint, "://") && !strings.Contains(req.Endpoint, ":") {
+				return nil, fmt.Errorf("unknown endpoint %q", req.Endpoint)
+			}
+			// Not configured, but it looks like an address
+			endpoint = config.Endpoint{Name: req.Endpoint, URL: req.Endpoint}
+		}
+		endpoints = []config.Endpoint{endpoint}
+	}
+
+	result := make([]*browserEndpoint, len(endpoints))
+	for i, endpoint := range endpoints {
+		result[i] = &browserEndpoint{name: endpoint.Name, url: endpoint.URL}
+	}
+	return result, nil
+}
+
+// selectTargets finds every tab the matchers select across the endpoints,
+// opening tabs for matchers that select nothing when allowed. Unreachable
+// endpoints and unmatched matchers are reported in response.Errors.
+func selectTargets(ctx context.Context, req *debugger.DebugRequest, matchers []debugger.TargetMatcher, endpoints []*browserEndpoint, response *debugger.DebugResponse) (map[string]*selectedTarget, error) {
+	targets := make(map[string]*selectedTarget)
+	var reachable []*browserEndpoint
+
+	for _, endpoint := range endpoints {
+		browser, err := debugger.ConnectBrowser(ctx, endpoint.url)
+		if err != nil {
+			fmt.Printf("❌ Endpoint %s unreachable: %v\n", endpoint.name, err)
+			response.Errors["endpoint:"+endpoint.name] = err.Error()
+			continue
+		}
+		endpoint.browser = browser
+
+		version, err := browser.Version(ctx)
+		if err != nil {
+			fmt.Printf("❌ Endpoint %s unreachable: %v\n", endpoint.name, err)
+			response.Errors["endpoint:"+endpoint.name] = err.Error()
+			continue
+		}
+		response.Browsers[endpoint.name] = *version
+
+		matches, err := browser.FindTargets(ctx, matchers)
+		if err != nil {
+			fmt.Printf("❌ Failed to get targets from %s: %v\n", endpoint.name, err)
+			response.Errors["endpoint:"+endpoint.name] = err.Error()
+			continue
+		}
+		reachable = append(reachable, endpoint)
+
+		for key, found := range matches {
+			for _, target := range found {
+				addSelected(targets, target, endpoint, key)
+			}
+		}
+	}
+
+	if len(reachable) == 0 {
+		return nil, fmt.Errorf("no browser endpoint reachable")
+	}
+
+	for i := range matchers {
+		key := matchers[i].Key()
+		if isMatched(targets, key) {
+			continue
+		}
+
+		// New tabs go to the first browser that answered
+		endpoint := reachable[0]
+		target, err := openMissingTarget(ctx, endpoint.browser, &matchers[i], req)
+		if err != nil {
+			fmt.Printf("❌ %s: %v\n", key, err)
+			response.Errors[key] = err.Error()
+			continue
+		}
+		addSelected(targets, target, endpoint, key)
+	}
+
+	return targets, nil
+}
+
+// closeEndpoints drops the connections selectTargets opened
+func closeEndpoints(endpoints []*browserEndpoint) {
+	for _, endpoint := range endpoints {
+		if endpoint.browser != nil {
+			endpoint.browser.Close()
+		}
+	}
+}
+
+// addSelected records that a matcher selected a target; a tab selected by
+// several matchers is only captured once
+func addSelected(targets map[string]*selectedTarget, target *debugger.DebuggingTarget, endpoint *browserEndpoint, key string) {
+	selected, ok := targets[target.ID]
+	if !ok {
+		selected = &selectedTarget{target: target, endpoint: endpoint}
+		targets[target.ID] = selected
+	}
+	if !containsString(selected.matched, key) {
+		selected.matched = append(selected.matched, key)
+	}
+}
+
+func isMatched(targets map[string]*selectedTarget, key string) bool {
+	for _, selected := range targets {
+		if containsString(selected.matched, key) {
+			return true
+		}
+	}
+	return false
+}
+
+// openTabs reports whether URLs without a matching target get a new tab
+func openTabs(req *debugger.DebugRequest) bool {
+	return req.OpenTabs == nil || *req.OpenTabs
+}
+
+// openMissingTarget opens a tab for a matcher that selected nothing, when
+// the request allows it and the matcher names a concrete URL
+func openMissingTarget(ctx context.Context, browser debugger.Browser, matcher *debugger.TargetMatcher, req *debugger.DebugRequest) (*debugger.DebuggingTarget, error) {
+	if !openTabs(req) || !matcher.CanOpen() {
+		return nil, fmt.Errorf("no matching target")
+	}
+	target, err := browser.OpenTarget(ctx, matcher.URL)
+	if err != nil {
+		return nil, fmt.Errorf("no matching target, failed to open a tab: %v", err)
+	}
+	return target, nil
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
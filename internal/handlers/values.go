@@ -0,0 +1,328 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"debugger-api/internal/debugger"
+	"debugger-api/internal/protocol"
+)
+
+// maxPropertyCalls caps the Runtime.getProperties round trips spent on the
+// arguments of a single console call
+const maxPropertyCalls = 64
+
+// valueLimits bounds how far console arguments are expanded
+type valueLimits struct {
+	depth      int // levels of nested objects expanded below an argument
+	properties int // properties, items or entries kept per object
+}
+
+func requestValueLimits(req *debugger.DebugRequest) valueLimits {
+	limits := valueLimits{depth: cfg.ValueDepth, properties: cfg.ValueProperties}
+	if req.ValueDepth > 0 {
+		limits.depth = req.ValueDepth
+	}
+	if req.ValueProperties > 0 {
+		limits.properties = req.ValueProperties
+	}
+	return limits
+}
+
+// valueExpander turns RemoteObjects into value trees, fetching nested
+// properties from the page while the objects are still alive
+type valueExpander struct {
+	client debugger.Caller
+	limits valueLimits
+	calls  int
+}
+
+func newValueExpander(client debugger.Caller, limits valueLimits) *valueExpander {
+	return &valueExpander{client: client, limits: limits}
+}
+
+// expand builds the value tree of obj; depth is how far below a console
+// argument obj sits
+func (e *valueExpander) expand(ctx context.Context, obj *protocol.RuntimeRemoteObject, depth int) *debugger.RemoteValue {
+	v := e.expandValue(ctx, obj, depth)
+	if v.Subtype == "error" {
+		v.Error = errorRecord(v)
+	}
+	return v
+}
+
+func (e *valueExpander) expandValue(ctx context.Context, obj *protocol.RuntimeRemoteObject, depth int) *debugger.RemoteValue {
+	v := &debugger.RemoteValue{
+		Type:           obj.Type,
+		Subtype:        obj.Subtype,
+		ClassName:      obj.ClassName,
+		Value:          obj.Value,
+		Unserializable: obj.UnserializableValue,
+		Description:    obj.Description,
+	}
+
+	if obj.Type == "function" {
+		// Functions describe themselves with their whole source
+		v.Description, _, _ = strings.Cut(obj.Description, "\n")
+		return v
+	}
+	if obj.Type != "object" || obj.Subtype == "null" {
+		return v
+	}
+
+	switch obj.Subtype {
+	case "array", "typedarray", "map", "set", "weakmap", "weakset", "arraybuffer":
+		v.Size = describedSize(obj.Description)
+	case "node", "date", "regexp", "promise", "proxy", "generator", "iterator", "wasmvalue":
+		// The description is the useful representation of these
+		return v
+	}
+
+	if obj.ObjectID == "" || depth >= e.limits.depth || e.calls >= maxPropertyCalls {
+		v.Truncated = true
+		return v
+	}
+
+	reply, err := e.properties(ctx, obj.ObjectID)
+	if err != nil {
+		v.Truncated = true
+		return v
+	}
+
+	switch obj.Subtype {
+	case "array", "typedarray":
+		e.expandItems(ctx, v, reply, depth)
+	case "map", "set", "weakmap", "weakset":
+		e.expandEntries(ctx, v, reply, depth)
+	default:
+		e.expandProperties(ctx, v, reply, depth)
+	}
+	return v
+}
+
+func (e *valueExpander) expandProperties(ctx context.Context, v *debugger.RemoteValue, reply *protocol.RuntimeGetPropertiesReturns, depth int) {
+	for _, prop := range reply.Result {
+		if prop.Value == nil || !prop.IsOwn || prop.Name == "__proto__" {
+			continue
+		}
+		if len(v.Properties) >= e.limits.properties {
+			v.Truncated = true
+			return
+		}
+		v.Properties = append(v.Properties, debugger.RemoteProperty{
+			Name:  prop.Name,
+			Value: e.expand(ctx, prop.Value, depth+1),
+		})
+	}
+}
+
+func (e *valueExpander) expandItems(ctx context.Context, v *debugger.RemoteValue, reply *protocol.RuntimeGetPropertiesReturns, depth int) {
+	for _, prop := range reply.Result {
+		if prop.Value == nil {
+			continue
+		}
+		if _, err := strconv.Atoi(prop.Name); err != nil {
+			continue
+		}
+		if len(v.Items) >= e.limits.properties {
+			v.Truncated = true
+			return
+		}
+		v.Items = append(v.Items, e.expand(ctx, prop.Value, depth+1))
+	}
+	if len(v.Items) < v.Size {
+		// Holes in sparse arrays are not listed
+		v.Truncated = true
+	}
+}
+
+// expandEntries reads a Map or Set through its [[Entries]] internal
+// property, a list of entry objects with "key" and "value" properties
+func (e *valueExpander) expandEntries(ctx context.Context, v *debugger.RemoteValue, reply *protocol.RuntimeGetPropertiesReturns, depth int) {
+	var list *protocol.RuntimeRemoteObject
+	for _, prop := range reply.InternalProperties {
+		if prop.Name == "[[Entries]]" {
+			list = prop.Value
+		}
+	}
+	if list == nil || list.ObjectID == "" {
+		v.Truncated = true
+		return
+	}
+
+	entries, err := e.properties(ctx, list.ObjectID)
+	if err != nil {
+		v.Truncated = true
+		return
+	}
+
+	for _, prop := range entries.Result {
+		if _, err := strconv.Atoi(prop.Name); err != nil || prop.Value == nil || prop.Value.ObjectID == "" {
+			continue
+		}
+		if len(v.Entries) >= e.limits.properties || e.calls >= maxPropertyCalls {
+			v.Truncated = true
+			return
+		}
+
+		fields, err := e.properties(ctx, prop.Value.ObjectID)
+		if err != nil {
+			v.Truncated = true
+			return
+		}
+		var entry debugger.RemoteEntry
+		for _, field := range fields.Result {
+			if field.Value == nil {
+				continue
+			}
+			switch field.Name {
+			case "key":
+				entry.Key = e.expand(ctx, field.Value, depth+1)
+			case "value":
+				entry.Value = e.expand(ctx, field.Value, depth+1)
+			}
+		}
+		if entry.Value != nil {
+			v.Entries = append(v.Entries, entry)
+		}
+	}
+}
+
+func (e *valueExpander) properties(ctx context.Context, objectID string) (*protocol.RuntimeGetPropertiesReturns, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	e.calls++
+	params := protocol.RuntimeGetPropertiesParams{
+		ObjectID:      objectID,
+		OwnProperties: true,
+	}
+
+	var reply protocol.RuntimeGetPropertiesReturns
+	if err := e.client.Call(ctx, protocol.CommandRuntimeGetProperties, params, &reply); err != nil {
+		fmt.Printf("❌ Failed to get properties for object %s: %v\n", objectID, err)
+		return nil, err
+	}
+	return &reply, nil
+}
+
+var sizePattern = regexp.MustCompile(`\((\d+)\)$`)
+
+// describedSize reads the length in descriptions like "Array(3)" or "Map(2)"
+func describedSize(description string) int {
+	if match := sizePattern.FindStringSubmatch(description); match != nil {
+		size, _ := strconv.Atoi(match[1])
+		return size
+	}
+	return 0
+}
+
+// renderValue formats a value tree the way the DevTools console prints
+// it. Top level strings are printed as they are, nested ones quoted.
+func renderValue(v *debugger.RemoteValue, nested bool) string {
+	switch v.Type {
+	case "string":
+		s, _ := v.Value.(string)
+		if nested {
+			return strconv.Quote(s)
+		}
+		return s
+	case "number", "boolean":
+		if v.Unserializable != "" {
+			return v.Unserializable
+		}
+		if n, ok := v.Value.(float64); ok {
+			return jsNumber(n)
+		}
+		return fmt.Sprint(v.Value)
+	case "bigint":
+		return v.Unserializable
+	case "undefined":
+		return "undefined"
+	case "symbol":
+		return v.Description
+	case "function":
+		signature := strings.TrimSpace(strings.TrimSuffix(v.Description, "{"))
+		return "ƒ " + strings.TrimPrefix(signature, "function ")
+	}
+
+	switch v.Subtype {
+	case "null":
+		return "null"
+	case "array", "typedarray":
+		return renderItems(v)
+	case "map", "set", "weakmap", "weakset":
+		return renderEntries(v)
+	case "error":
+		if nested {
+			firstLine, _, _ := strings.Cut(v.Description, "\n")
+			return firstLine
+		}
+		return v.Description
+	case "", "object":
+		return renderObject(v)
+	}
+	return v.Description
+}
+
+func renderItems(v *debugger.RemoteValue) string {
+	if v.Items == nil && v.Truncated {
+		return v.Description
+	}
+
+	items := make([]string, 0, len(v.Items)+1)
+	for _, item := range v.Items {
+		items = append(items, renderValue(item, true))
+	}
+	if v.Truncated {
+		items = append(items, "…")
+	}
+
+	prefix := ""
+	if v.Subtype == "typedarray" {
+		prefix = v.Description + " "
+	}
+	return prefix + "[" + strings.Join(items, ", ") + "]"
+}
+
+func renderEntries(v *debugger.RemoteValue) string {
+	if v.Entries == nil && v.Truncated {
+		return v.Description
+	}
+
+	entries := make([]string, 0, len(v.Entries)+1)
+	for _, entry := range v.Entries {
+		if entry.Key != nil {
+			entries = append(entries, renderValue(entry.Key, true)+" => "+renderValue(entry.Value, true))
+		} else {
+			entries = append(entries, renderValue(entry.Value, true))
+		}
+	}
+	if v.Truncated {
+		entries = append(entries, "…")
+	}
+	return v.Description + " {" + strings.Join(entries, ", ") + "}"
+}
+
+func renderObject(v *debugger.RemoteValue) string {
+	prefix := ""
+	if v.ClassName != "" && v.ClassName != "Object" {
+		prefix = v.ClassName + " "
+	}
+	if v.Properties == nil && v.Truncated {
+		return prefix + "{…}"
+	}
+
+	props := make([]string, 0, len(v.Properties)+1)
+	for _, prop := range v.Properties {
+		props = append(props, prop.Name+": "+renderValue(prop.Value, true))
+	}
+	if v.Truncated {
+		props = append(props, "…")
+	}
+	return prefix + "{" + strings.Join(props, ", ") + "}"
+}
@@ -0,0 +1,147 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"debugger-api/internal/protocol"
+)
+
+func remoteString(s string) string {
+	quoted, _ := json.Marshal(s)
+	return `{"type":"string","value":` + string(quoted) + `}`
+}
+
+func remoteNumber(n int) string {
+	return fmt.Sprintf(`{"type":"number","value":%d,"description":"%d"}`, n, n)
+}
+
+func remoteObject(id string) string {
+	return `{"type":"object","className":"Object","description":"Object","objectId":"` + id + `"}`
+}
+
+func remoteArray(id string, size int) string {
+	return fmt.Sprintf(`{"type":"object","subtype":"array","className":"Array","description":"Array(%d)","objectId":"%s"}`, size, id)
+}
+
+// ownProperty is a property descriptor as Runtime.getProperties lists it
+func ownProperty(name, value string) string {
+	return `{"name":"` + name + `","value":` + value + `,"configurable":true,"enumerable":true,"isOwn":true}`
+}
+
+func propertiesReply(props ...string) string {
+	return `{"result":[` + strings.Join(props, ",") + `]}`
+}
+
+// entriesReply is the reply for a Map or Set, whose entries sit behind the
+// [[Entries]] internal property
+func entriesReply(id string, size int) string {
+	return `{"result":[],"internalProperties":[{"name":"[[Entries]]","value":` + remoteArray(id, size) + `}]}`
+}
+
+// valuePage holds a user object, a Map and a Set:
+//
+//	user = {name: "ada", address: {city: "London", geo: {lat: 51}}, tags: ["a", "b", "c"]}
+//	map  = new Map([["a", 1], ["b", user.address]])
+//	set  = new Set(["x"])
+func valuePage() *fakePage {
+	return &fakePage{replies: map[string]string{
+		"user": propertiesReply(
+			ownProperty("name", remoteString("ada")),
+			ownProperty("address", remoteObject("address")),
+			ownProperty("tags", remoteArray("tags", 3)),
+			`{"name":"inherited","value":`+remoteNumber(1)+`,"configurable":true,"enumerable":true,"isOwn":false}`,
+			ownProperty("__proto__", remoteObject("proto")),
+		),
+		"address": propertiesReply(ownProperty("city", remoteString("London")), ownProperty("geo", remoteObject("geo"))),
+		"geo":     propertiesReply(ownProperty("lat", remoteNumber(51))),
+		"tags": propertiesReply(
+			ownProperty("0", remoteString("a")),
+			ownProperty("1", remoteString("b")),
+			ownProperty("2", remoteString("c")),
+			ownProperty("length", remoteNumber(3)),
+		),
+		"map": entriesReply("map-entries", 2),
+		"map-entries": propertiesReply(
+			ownProperty("0", remoteObject("map-0")),
+			ownProperty("1", remoteObject("map-1")),
+			ownProperty("length", remoteNumber(2)),
+		),
+		"map-0":       propertiesReply(ownProperty("key", remoteString("a")), ownProperty("value", remoteNumber(1))),
+		"map-1":       propertiesReply(ownProperty("key", remoteString("b")), ownProperty("value", remoteObject("address"))),
+		"set":         entriesReply("set-entries", 1),
+		"set-entries": propertiesReply(ownProperty("0", remoteObject("set-0")), ownProperty("length", remoteNumber(1))),
+		"set-0":       propertiesReply(ownProperty("value", remoteString("x"))),
+	}}
+}
+
+func TestValueExpander(t *testing.T) {
+	user := remoteObject("user")
+	tags := remoteArray("tags", 3)
+	sparse := remoteArray("tags", 5)
+	mapObject := `{"type":"object","subtype":"map","className":"Map","description":"Map(2)","objectId":"map"}`
+	setObject := `{"type":"object","subtype":"set","className":"Set","description":"Set(1)","objectId":"set"}`
+	gone := remoteObject("gone")
+
+	tests := []struct {
+		name   string
+		obj    string
+		limits valueLimits
+		want   string
+		calls  int
+	}{
+		{"depth 0", user, valueLimits{depth: 0, properties: 10}, "{…}", 0},
+		{"depth 1", user, valueLimits{depth: 1, properties: 10}, `{name: "ada", address: {…}, tags: Array(3)}`, 1},
+		{"depth 2", user, valueLimits{depth: 2, properties: 10}, `{name: "ada", address: {city: "London", geo: {…}}, tags: ["a", "b", "c"]}`, 3},
+		{"depth 3", user, valueLimits{depth: 3, properties: 10}, `{name: "ada", address: {city: "London", geo: {lat: 51}}, tags: ["a", "b", "c"]}`, 4},
+		{"property limit", user, valueLimits{depth: 3, properties: 1}, `{name: "ada", …}`, 1},
+		{"item limit", tags, valueLimits{depth: 1, properties: 2}, `["a", "b", …]`, 1},
+		{"holes in sparse arrays", sparse, valueLimits{depth: 1, properties: 10}, `["a", "b", "c", …]`, 1},
+		{"map entries", mapObject, valueLimits{depth: 2, properties: 10}, `Map(2) {"a" => 1, "b" => {city: "London", geo: {…}}}`, 5},
+		{"map entries at depth 1", mapObject, valueLimits{depth: 1, properties: 10}, `Map(2) {"a" => 1, "b" => {…}}`, 4},
+		{"map entry limit", mapObject, valueLimits{depth: 2, properties: 1}, `Map(2) {"a" => 1, …}`, 3},
+		{"set entries", setObject, valueLimits{depth: 1, properties: 10}, `Set(1) {"x"}`, 3},
+		{"object released by the page", gone, valueLimits{depth: 1, properties: 10}, "{…}", 1},
+	}
+
+	for _, tt := range tests {
+		var obj protocol.RuntimeRemoteObject
+		if err := json.Unmarshal([]byte(tt.obj), &obj); err != nil {
+			t.Fatal(err)
+		}
+		page := valuePage()
+		e := newValueExpander(page, tt.limits)
+		if got := renderValue(e.expand(context.Background(), &obj, 0), false); got != tt.want {
+			t.Errorf("%s: expand = %s, want %s", tt.name, got, tt.want)
+		}
+		if page.calls != tt.calls {
+			t.Errorf("%s: %d Runtime.getProperties calls, want %d", tt.name, page.calls, tt.calls)
+		}
+	}
+}
+
+func TestValueExpanderCallLimit(t *testing.T) {
+	// A linked list longer than the calls one console call may spend
+	page := &fakePage{replies: make(map[string]string)}
+	for i := 0; i < 2*maxPropertyCalls; i++ {
+		page.replies[fmt.Sprint(i)] = propertiesReply(ownProperty("next", remoteObject(fmt.Sprint(i+1))))
+	}
+
+	e := newValueExpander(page, valueLimits{depth: 4 * maxPropertyCalls, properties: 10})
+	v := e.expand(context.Background(), &protocol.RuntimeRemoteObject{Type: "object", ClassName: "Object", ObjectID: "0"}, 0)
+	if page.calls != maxPropertyCalls {
+		t.Errorf("%d Runtime.getProperties calls, want %d", page.calls, maxPropertyCalls)
+	}
+
+	depth := 0
+	for v.Properties != nil {
+		v = v.Properties[0].Value
+		depth++
+	}
+	if depth != maxPropertyCalls || !v.Truncated {
+		t.Errorf("expanded %d levels, last truncated %v, want %d levels", depth, v.Truncated, maxPropertyCalls)
+	}
+}
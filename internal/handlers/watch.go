@@ -0,0 +1,380 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"debugger-api/internal/debugger"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+const (
+	// watchPollInterval is how often a watch looks for new matching tabs
+	// and reconnects to browsers that went away
+	watchPollInterval = 5 * time.Second
+
+	defaultWatchBucket = time.Minute
+
+	// A tab is given up after this many attaches in a row failed; it is
+	// picked up again if a later poll still finds it
+	watchAttachAttempts = 5
+	watchRetryDelay     = time.Second
+	maxWatchRetryDelay  = 30 * time.Second
+)
+
+// States of a watched tab
+const (
+	watchAttaching = "attaching"
+	watchCapturing = "capturing"
+	watchRetrying  = "retrying"
+)
+
+// watchedTarget is a tab a watch is attached to
+type watchedTarget struct {
+	ID        string    `json:"id"`
+	URL       string    `json:"url"`
+	Endpoint  string    `json:"endpoint"`
+	Matched   []string  `json:"matched"`
+	State     string    `json:"state"`
+	Attaches  int       `json:"attaches"`
+	Since     time.Time `json:"since"`
+	LastError string    `json:"lastError,omitempty"`
+}
+
+// watcher keeps CDP sessions open on every tab its matchers select. Tabs
+// are reattached after they detach or crash, browsers are reconnected
+// after a restart, and messages are saved in time buckets as they come in.
+type watcher struct {
+	id       string
+	session  *debugSession
+	bucket   time.Duration
+	started  time.Time
+	cancel   context.CancelFunc
+	captures sync.WaitGroup
+
+	mu          sync.Mutex
+	targets     map[string]*watchedTarget // endpoint name + "/" + target ID
+	unreachable map[string]bool           // endpoints the last poll could not reach
+}
+
+var (
+	watchersMu sync.Mutex
+	watchers   = make(map[string]*watcher)
+)
+
+// StartWatch starts watching the tabs a request matches
+func StartWatch(c *fiber.Ctx) error {
+	var req debugger.WatchRequest
+	if err := c.BodyParser(&req); err != nil {
+		fmt.Printf("❌ Invalid request body: %v\n", err)
+		return c.Status(400).JSON(fiber.Map{"error": "Invalid request"})
+	}
+
+	session, err := newDebugSession(&req.DebugRequest)
+	if err != nil {
+		return c.Status(400).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	id, err := newJobID()
+	if err != nil {
+		return c.Status(500).JSON(fiber.Map{"error": "Failed to start watch"})
+	}
+
+	w := &watcher{
+		id:          id,
+		session:     session,
+		bucket:      defaultWatchBucket,
+		started:     time.Now(),
+		targets:     make(map[string]*watchedTarget),
+		unreachable: make(map[string]bool),
+	}
+	if req.BucketMs > 0 {
+		w.bucket = time.Duration(req.BucketMs) * time.Millisecond
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	w.cancel = cancel
+
+	watchersMu.Lock()
+	watchers[id] = w
+	watchersMu.Unlock()
+
+	fmt.Printf("👀 Started watch %s\n", id)
+	go w.run(ctx)
+	return c.Status(201).JSON(w.status())
+}
+
+// GetWatches lists the running watches and the tabs they are attached to
+func GetWatches(c *fiber.Ctx) error {
+	watchersMu.Lock()
+	list := make([]fiber.Map, 0, len(watchers))
+	for _, w := range watchers {
+		list = append(list, w.status())
+	}
+	watchersMu.Unlock()
+
+	return c.JSON(fiber.Map{"watches": list})
+}
+
+// StopWatch stops a watch and detaches from its tabs
+func StopWatch(c *fiber.Ctx) error {
+	id := c.Params("id")
+
+	watchersMu.Lock()
+	w, ok := watchers[id]
+	delete(watchers, id)
+	watchersMu.Unlock()
+	if !ok {
+		return c.Status(404).JSON(fiber.Map{"error": "Watch not found"})
+	}
+
+	fmt.Printf("🛑 Stopping watch %s\n", id)
+	w.cancel()
+	return c.JSON(fiber.Map{"id": id, "status": "stopping"})
+}
+
+func (w *watcher) status() fiber.Map {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	targets := make([]watchedTarget, 0, len(w.targets))
+	for _, target := range w.targets {
+		targets = append(targets, *target)
+	}
+	return fiber.Map{
+		"id":       w.id,
+		"started":  w.started,
+		"bucketMs": w.bucket.Milliseconds(),
+		"request":  w.session.req,
+		"targets":  targets,
+	}
+}
+
+func (w *watcher) run(ctx context.Context) {
+	ticker := time.NewTicker(watchPollInterval)
+	defer ticker.Stop()
+
+	for {
+		w.discover(ctx)
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			w.captures.Wait()
+			closeEndpoints(w.session.endpoints)
+			fmt.Printf("👋 Watch %s stopped\n", w.id)
+			return
+		}
+	}
+}
+
+// discover connects to the watch's browsers and starts capturing every
+// matching tab that is not watched yet
+func (w *watcher) discover(ctx context.Context) {
+	for _, endpoint := range w.session.endpoints {
+		browser := w.browser(endpoint)
+		if browser == nil {
+			var err error
+			if browser, err = debugger.ConnectBrowser(ctx, endpoint.url); err != nil {
+				w.setReachable(endpoint, err)
+				continue
+			}
+			w.mu.Lock()
+			endpoint.browser = browser
+			w.mu.Unlock()
+		}
+
+		matches, err := browser.FindTargets(ctx, w.session.matchers)
+		if err != nil {
+			// The browser may have restarted; reconnect on the next poll
+			w.mu.Lock()
+			endpoint.browser = nil
+			w.mu.Unlock()
+			browser.Close()
+			w.setReachable(endpoint, err)
+			continue
+		}
+		w.setReachable(endpoint, nil)
+
+		found := make(map[string]*selectedTarget)
+		for key, targets := range matches {
+			for _, target := range targets {
+				addSelected(found, target, endpoint, key)
+			}
+		}
+
+		for id, selected := range found {
+			key := endpoint.name + "/" + id
+			w.mu.Lock()
+			_, watched := w.targets[key]
+			if !watched {
+				w.targets[key] = &watchedTarget{
+					ID:       id,
+					URL:      selected.target.URL,
+					Endpoint: endpoint.name,
+					Matched:  selected.matched,
+					State:    watchAttaching,
+					Since:    time.Now(),
+				}
+			}
+			w.mu.Unlock()
+
+			if !watched {
+				fmt.Printf("👀 Watch %s: found %s (%s)\n", w.id, selected.target.URL, key)
+				w.captures.Add(1)
+				go w.watchTarget(ctx, key, selected)
+			}
+		}
+	}
+}
+
+// browser returns the endpoint's current connection, nil while it is down
+func (w *watcher) browser(endpoint *browserEndpoint) debugger.Browser {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return endpoint.browser
+}
+
+// setReachable logs when an endpoint goes down or comes back
+func (w *watcher) setReachable(endpoint *browserEndpoint, err error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if err != nil && !w.unreachable[endpoint.name] {
+		fmt.Printf("❌ Watch %s: endpoint %s unreachable: %v\n", w.id, endpoint.name, err)
+	} else if err == nil && w.unreachable[endpoint.name] {
+		fmt.Printf("✅ Watch %s: endpoint %s is back\n", w.id, endpoint.name)
+	}
+	w.unreachable[endpoint.name] = err != nil
+}
+
+func (w *watcher) setState(key, state string, err error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	target, ok := w.targets[key]
+	if !ok {
+		return
+	}
+	target.State = state
+	target.Since = time.Now()
+	if state == watchCapturing {
+		target.Attaches++
+	}
+	if err != nil {
+		target.LastError = err.Error()
+	}
+}
+
+// watchTarget keeps a tab captured, reattaching whenever the session ends,
+// until the watch stops, the tab is closed or it cannot be attached to
+func (w *watcher) watchTarget(ctx context.Context, key string, selected *selectedTarget) {
+	defer w.captures.Done()
+	defer func() {
+		w.mu.Lock()
+		delete(w.targets, key)
+		w.mu.Unlock()
+	}()
+
+	reload, failures := false, 0
+	for {
+		w.setState(key, watchAttaching, nil)
+		reason, err := w.captureTarget(ctx, key, selected, reload)
+		if ctx.Err() != nil {
+			return
+		}
+
+		if err != nil {
+			failures++
+			fmt.Printf("⚠️ Watch %s: %s: %v\n", w.id, key, err)
+			if failures >= watchAttachAttempts {
+				fmt.Printf("👋 Watch %s: giving up on %s\n", w.id, key)
+				return
+			}
+		} else {
+			if reason == stopClosed {
+				fmt.Printf("👋 Watch %s: %s was closed\n", w.id, key)
+				return
+			}
+			failures = 0
+			// A crashed tab stays on its error page until it is reloaded
+			reload = reason == stopCrashed
+			fmt.Printf("🔁 Watch %s: %s ended (%s), reattaching\n", w.id, key, reason)
+		}
+		w.setState(key, watchRetrying, err)
+
+		delay := watchRetryDelay << failures
+		if delay > maxWatchRetryDelay {
+			delay = maxWatchRetryDelay
+		}
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// captureTarget attaches to a tab and saves what it logs bucket by bucket
+// until the session ends. It returns why it ended.
+func (w *watcher) captureTarget(ctx context.Context, key string, selected *selectedTarget, reload bool) (string, error) {
+	browser := w.browser(selected.endpoint)
+	if browser == nil {
+		return "", fmt.Errorf("endpoint %s is not connected", selected.endpoint.name)
+	}
+
+	// Watching never navigates on its own, except to recover a crashed tab
+	req := *w.session.req
+	req.NavigateURL = ""
+	req.Reload = reload
+
+	capture, err := startCapture(ctx, browser, selected.target, &req)
+	if err != nil {
+		return "", err
+	}
+	defer capture.close()
+
+	if err := navigateTarget(ctx, capture.page, selected.target, &req); err != nil {
+		return "", err
+	}
+	w.setState(key, watchCapturing, nil)
+
+	reported := 0
+	for {
+		// Buckets are aligned to the clock so every tab shares them
+		start := time.Now().Truncate(w.bucket)
+		remaining := time.Until(start.Add(w.bucket))
+		stop := debugger.StopConditions{MaxDurationMs: int(remaining.Milliseconds()) + 1}
+
+		reason := captureDebugMessages(ctx, capture, capture.events, stop)
+
+		results := capture.results(ctx, reason)
+		results.Navigations = results.Navigations[reported:]
+		reported = len(capture.navigations)
+		capture.reset()
+		w.save(start, selected, results)
+
+		if reason != stopMaxDuration {
+			return reason, nil
+		}
+	}
+}
+
+// save stores one bucket of a tab's capture under each matcher that
+// selected it
+func (w *watcher) save(start time.Time, selected *selectedTarget, results debugger.PageResults) {
+	if len(results.Console)+len(results.Errors)+len(results.Network)+len(results.Issues)+len(results.Navigations) == 0 {
+		return
+	}
+
+	results.Endpoint = selected.endpoint.name
+	results.Matched = selected.matched
+	for _, key := range selected.matched {
+		bucket := map[string]debugger.PageResults{selected.target.ID: results}
+		if err := store.SaveBucket(key, w.id, start, bucket); err != nil {
+			fmt.Printf("❌ Failed to save watch bucket for %s: %v\n", key, err)
+		}
+	}
+}
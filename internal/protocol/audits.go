@@ -0,0 +1,408 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package protocol
+
+import (
+	"encoding/json"
+)
+
+// Audits domain: Audits domain allows investigation of page violations and possible improvements.
+
+const (
+	CommandAuditsGetEncodedResponse = "Audits.getEncodedResponse"
+	CommandAuditsDisable            = "Audits.disable"
+	CommandAuditsEnable             = "Audits.enable"
+	CommandAuditsCheckContrast      = "Audits.checkContrast"
+	CommandAuditsCheckFormsIssues   = "Audits.checkFormsIssues"
+	EventAuditsIssueAdded           = "Audits.issueAdded"
+)
+
+// AuditsAffectedCookie: Information about a cookie that is affected by an inspector issue.
+type AuditsAffectedCookie struct {
+	// The following three properties uniquely identify a cookie
+	Name   string `json:"name"`
+	Path   string `json:"path"`
+	Domain string `json:"domain"`
+}
+
+// AuditsAffectedRequest: Information about a request that is affected by an inspector issue.
+type AuditsAffectedRequest struct {
+	// The unique request id.
+	RequestID NetworkRequestID `json:"requestId,omitempty"`
+	URL       string           `json:"url"`
+}
+
+// AuditsAffectedFrame: Information about the frame affected by an inspector issue.
+type AuditsAffectedFrame struct {
+	FrameID PageFrameID `json:"frameId"`
+}
+
+type AuditsCookieExclusionReason = string
+
+type AuditsCookieWarningReason = string
+
+type AuditsCookieOperation = string
+
+// AuditsInsightType: Represents the category of insight that a cookie issue falls under.
+type AuditsInsightType = string
+
+// AuditsCookieIssueInsight: Information about the suggested solution to a cookie issue.
+type AuditsCookieIssueInsight struct {
+	Type AuditsInsightType `json:"type"`
+	// Link to table entry in third-party cookie migration readiness list.
+	TableEntryURL string `json:"tableEntryUrl,omitempty"`
+}
+
+// AuditsCookieIssueDetails: This information is currently necessary, as the front-end has a difficult time finding a specific cookie. With this, we can convey specific error information without the cookie.
+type AuditsCookieIssueDetails struct {
+	// If AffectedCookie is not set then rawCookieLine contains the raw Set-Cookie header string. This hints at a problem where the cookie line is syntactically or semantically malformed in a way that no valid cookie could be created.
+	Cookie                 *AuditsAffectedCookie         `json:"cookie,omitempty"`
+	RawCookieLine          string                        `json:"rawCookieLine,omitempty"`
+	CookieWarningReasons   []AuditsCookieWarningReason   `json:"cookieWarningReasons"`
+	CookieExclusionReasons []AuditsCookieExclusionReason `json:"cookieExclusionReasons"`
+	// Optionally identifies the site-for-cookies and the cookie url, which may be used by the front-end as additional context.
+	Operation      AuditsCookieOperation  `json:"operation"`
+	SiteForCookies string                 `json:"siteForCookies,omitempty"`
+	CookieURL      string                 `json:"cookieUrl,omitempty"`
+	Request        *AuditsAffectedRequest `json:"request,omitempty"`
+	// The recommended solution to the issue.
+	Insight *AuditsCookieIssueInsight `json:"insight,omitempty"`
+}
+
+type AuditsMixedContentResolutionStatus = string
+
+type AuditsMixedContentResourceType = string
+
+type AuditsMixedContentIssueDetails struct {
+	// The type of resource causing the mixed content issue (css, js, iframe, form,...). Marked as optional because it is mapped to from blink::mojom::RequestContextType, which will be replaced by network::mojom::RequestDestination
+	ResourceType AuditsMixedContentResourceType `json:"resourceType,omitempty"`
+	// The way the mixed content issue is being resolved.
+	ResolutionStatus AuditsMixedContentResolutionStatus `json:"resolutionStatus"`
+	// The unsafe http url causing the mixed content issue.
+	InsecureURL string `json:"insecureURL"`
+	// The url responsible for the call to an unsafe url.
+	MainResourceURL string `json:"mainResourceURL"`
+	// The mixed content request. Does not always exist (e.g. for unsafe form submission urls).
+	Request *AuditsAffectedRequest `json:"request,omitempty"`
+	// Optional because not every mixed content issue is necessarily linked to a frame.
+	Frame *AuditsAffectedFrame `json:"frame,omitempty"`
+}
+
+// AuditsBlockedByResponseReason: Enum indicating the reason a response has been blocked. These reasons are refinements of the net error BLOCKED_BY_RESPONSE.
+type AuditsBlockedByResponseReason = string
+
+// AuditsBlockedByResponseIssueDetails: Details for a request that has been blocked with the BLOCKED_BY_RESPONSE code. Currently only used for COEP/COOP, but may be extended to include some CSP errors in the future.
+type AuditsBlockedByResponseIssueDetails struct {
+	Request      AuditsAffectedRequest         `json:"request"`
+	ParentFrame  *AuditsAffectedFrame          `json:"parentFrame,omitempty"`
+	BlockedFrame *AuditsAffectedFrame          `json:"blockedFrame,omitempty"`
+	Reason       AuditsBlockedByResponseReason `json:"reason"`
+}
+
+type AuditsHeavyAdResolutionStatus = string
+
+type AuditsHeavyAdReason = string
+
+type AuditsHeavyAdIssueDetails struct {
+	// The resolution status, either blocking the content or warning.
+	Resolution AuditsHeavyAdResolutionStatus `json:"resolution"`
+	// The reason the ad was blocked, total network or cpu or peak cpu.
+	Reason AuditsHeavyAdReason `json:"reason"`
+	// The frame that was blocked.
+	Frame AuditsAffectedFrame `json:"frame"`
+}
+
+type AuditsContentSecurityPolicyViolationType = string
+
+type AuditsSourceCodeLocation struct {
+	ScriptID     RuntimeScriptID `json:"scriptId,omitempty"`
+	URL          string          `json:"url"`
+	LineNumber   int             `json:"lineNumber"`
+	ColumnNumber int             `json:"columnNumber"`
+}
+
+type AuditsContentSecurityPolicyIssueDetails struct {
+	// The url not included in allowed sources.
+	BlockedURL string `json:"blockedURL,omitempty"`
+	// Specific directive that is violated, causing the CSP issue.
+	ViolatedDirective                  string                                   `json:"violatedDirective"`
+	IsReportOnly                       bool                                     `json:"isReportOnly"`
+	ContentSecurityPolicyViolationType AuditsContentSecurityPolicyViolationType `json:"contentSecurityPolicyViolationType"`
+	FrameAncestor                      *AuditsAffectedFrame                     `json:"frameAncestor,omitempty"`
+	SourceCodeLocation                 *AuditsSourceCodeLocation                `json:"sourceCodeLocation,omitempty"`
+	ViolatingNodeID                    json.RawMessage                          `json:"violatingNodeId,omitempty"`
+}
+
+type AuditsSharedArrayBufferIssueType = string
+
+// AuditsSharedArrayBufferIssueDetails: Details for a issue arising from an SAB being instantiated in, or transferred to a context that is not cross-origin isolated.
+type AuditsSharedArrayBufferIssueDetails struct {
+	SourceCodeLocation AuditsSourceCodeLocation         `json:"sourceCodeLocation"`
+	IsWarning          bool                             `json:"isWarning"`
+	Type               AuditsSharedArrayBufferIssueType `json:"type"`
+}
+
+type AuditsLowTextContrastIssueDetails struct {
+	ViolatingNodeID       json.RawMessage `json:"violatingNodeId"`
+	ViolatingNodeSelector string          `json:"violatingNodeSelector"`
+	ContrastRatio         float64         `json:"contrastRatio"`
+	ThresholdAA           float64         `json:"thresholdAA"`
+	ThresholdAAA          float64         `json:"thresholdAAA"`
+	FontSize              string          `json:"fontSize"`
+	FontWeight            string          `json:"fontWeight"`
+}
+
+// AuditsCorsIssueDetails: Details for a CORS related issue, e.g. a warning or error related to CORS RFC1918 enforcement.
+type AuditsCorsIssueDetails struct {
+	CorsErrorStatus        NetworkCorsErrorStatus      `json:"corsErrorStatus"`
+	IsWarning              bool                        `json:"isWarning"`
+	Request                AuditsAffectedRequest       `json:"request"`
+	Location               *AuditsSourceCodeLocation   `json:"location,omitempty"`
+	InitiatorOrigin        string                      `json:"initiatorOrigin,omitempty"`
+	ResourceIPAddressSpace NetworkIPAddressSpace       `json:"resourceIPAddressSpace,omitempty"`
+	ClientSecurityState    *NetworkClientSecurityState `json:"clientSecurityState,omitempty"`
+}
+
+type AuditsAttributionReportingIssueType = string
+
+type AuditsSharedDictionaryError = string
+
+type AuditsSRIMessageSignatureError = string
+
+type AuditsUnencodedDigestError = string
+
+// AuditsAttributionReportingIssueDetails: Details for issues around "Attribution Reporting API" usage. Explainer: https://github.com/WICG/attribution-reporting-api
+type AuditsAttributionReportingIssueDetails struct {
+	ViolationType    AuditsAttributionReportingIssueType `json:"violationType"`
+	Request          *AuditsAffectedRequest              `json:"request,omitempty"`
+	ViolatingNodeID  json.RawMessage                     `json:"violatingNodeId,omitempty"`
+	InvalidParameter string                              `json:"invalidParameter,omitempty"`
+}
+
+// AuditsQuirksModeIssueDetails: Details for issues about documents in Quirks Mode or Limited Quirks Mode that affects page layouting.
+type AuditsQuirksModeIssueDetails struct {
+	// If false, it means the document's mode is "quirks" instead of "limited-quirks".
+	IsLimitedQuirksMode bool            `json:"isLimitedQuirksMode"`
+	DocumentNodeID      json.RawMessage `json:"documentNodeId"`
+	URL                 string          `json:"url"`
+	FrameID             PageFrameID     `json:"frameId"`
+	LoaderID            NetworkLoaderID `json:"loaderId"`
+}
+
+type AuditsNavigatorUserAgentIssueDetails struct {
+	URL      string                    `json:"url"`
+	Location *AuditsSourceCodeLocation `json:"location,omitempty"`
+}
+
+type AuditsSharedDictionaryIssueDetails struct {
+	SharedDictionaryError AuditsSharedDictionaryError `json:"sharedDictionaryError"`
+	Request               AuditsAffectedRequest       `json:"request"`
+}
+
+type AuditsSRIMessageSignatureIssueDetails struct {
+	Error               AuditsSRIMessageSignatureError `json:"error"`
+	SignatureBase       string                         `json:"signatureBase"`
+	IntegrityAssertions []string                       `json:"integrityAssertions"`
+	Request             AuditsAffectedRequest          `json:"request"`
+}
+
+type AuditsUnencodedDigestIssueDetails struct {
+	Error   AuditsUnencodedDigestError `json:"error"`
+	Request AuditsAffectedRequest      `json:"request"`
+}
+
+type AuditsGenericIssueErrorType = string
+
+// AuditsGenericIssueDetails: Depending on the concrete errorType, different properties are set.
+type AuditsGenericIssueDetails struct {
+	// Issues with the same errorType are aggregated in the frontend.
+	ErrorType              AuditsGenericIssueErrorType `json:"errorType"`
+	FrameID                PageFrameID                 `json:"frameId,omitempty"`
+	ViolatingNodeID        json.RawMessage             `json:"violatingNodeId,omitempty"`
+	ViolatingNodeAttribute string                      `json:"violatingNodeAttribute,omitempty"`
+	Request                *AuditsAffectedRequest      `json:"request,omitempty"`
+}
+
+// AuditsDeprecationIssueDetails: This issue tracks information needed to print a deprecation message. https://source.chromium.org/chromium/chromium/src/+/main:third_party/blink/renderer/core/frame/third_party/blink/renderer/core/frame/deprecation/README.md
+type AuditsDeprecationIssueDetails struct {
+	AffectedFrame      *AuditsAffectedFrame     `json:"affectedFrame,omitempty"`
+	SourceCodeLocation AuditsSourceCodeLocation `json:"sourceCodeLocation"`
+	// One of the deprecation names from third_party/blink/renderer/core/frame/deprecation/deprecation.json5
+	Type string `json:"type"`
+}
+
+// AuditsBounceTrackingIssueDetails: This issue warns about sites in the redirect chain of a finished navigation that may be flagged as trackers and have their state cleared if they don't receive a user interaction. Note that in this context 'site' means eTLD+1. For example, if the URL `https://example.test:80/bounce` was in the redirect chain, the site reported would be `example.test`.
+type AuditsBounceTrackingIssueDetails struct {
+	TrackingSites []string `json:"trackingSites"`
+}
+
+// AuditsCookieDeprecationMetadataIssueDetails: This issue warns about third-party sites that are accessing cookies on the current page, and have been permitted due to having a global metadata grant. Note that in this context 'site' means eTLD+1. For example, if the URL `https://example.test:80/web_page` was accessing cookies, the site reported would be `example.test`.
+type AuditsCookieDeprecationMetadataIssueDetails struct {
+	AllowedSites     []string              `json:"allowedSites"`
+	OptOutPercentage float64               `json:"optOutPercentage"`
+	IsOptOutTopLevel bool                  `json:"isOptOutTopLevel"`
+	Operation        AuditsCookieOperation `json:"operation"`
+}
+
+type AuditsClientHintIssueReason = string
+
+type AuditsFederatedAuthRequestIssueDetails struct {
+	FederatedAuthRequestIssueReason AuditsFederatedAuthRequestIssueReason `json:"federatedAuthRequestIssueReason"`
+}
+
+// AuditsFederatedAuthRequestIssueReason: Represents the failure reason when a federated authentication reason fails. Should be updated alongside RequestIdTokenStatus in third_party/blink/public/mojom/devtools/inspector_issue.mojom to include all cases except for success.
+type AuditsFederatedAuthRequestIssueReason = string
+
+type AuditsFederatedAuthUserInfoRequestIssueDetails struct {
+	FederatedAuthUserInfoRequestIssueReason AuditsFederatedAuthUserInfoRequestIssueReason `json:"federatedAuthUserInfoRequestIssueReason"`
+}
+
+// AuditsFederatedAuthUserInfoRequestIssueReason: Represents the failure reason when a getUserInfo() call fails. Should be updated alongside FederatedAuthUserInfoRequestResult in third_party/blink/public/mojom/devtools/inspector_issue.mojom.
+type AuditsFederatedAuthUserInfoRequestIssueReason = string
+
+// AuditsClientHintIssueDetails: This issue tracks client hints related issues. It's used to deprecate old features, encourage the use of new ones, and provide general guidance.
+type AuditsClientHintIssueDetails struct {
+	SourceCodeLocation    AuditsSourceCodeLocation    `json:"sourceCodeLocation"`
+	ClientHintIssueReason AuditsClientHintIssueReason `json:"clientHintIssueReason"`
+}
+
+type AuditsFailedRequestInfo struct {
+	// The URL that failed to load.
+	URL string `json:"url"`
+	// The failure message for the failed request.
+	FailureMessage string           `json:"failureMessage"`
+	RequestID      NetworkRequestID `json:"requestId,omitempty"`
+}
+
+type AuditsPartitioningBlobURLInfo = string
+
+type AuditsPartitioningBlobURLIssueDetails struct {
+	// The BlobURL that failed to load.
+	URL string `json:"url"`
+	// Additional information about the Partitioning Blob URL issue.
+	PartitioningBlobURLInfo AuditsPartitioningBlobURLInfo `json:"partitioningBlobURLInfo"`
+}
+
+type AuditsElementAccessibilityIssueReason = string
+
+// AuditsElementAccessibilityIssueDetails: This issue warns about errors in the select or summary element content model.
+type AuditsElementAccessibilityIssueDetails struct {
+	NodeID                          json.RawMessage                       `json:"nodeId"`
+	ElementAccessibilityIssueReason AuditsElementAccessibilityIssueReason `json:"elementAccessibilityIssueReason"`
+	HasDisallowedAttributes         bool                                  `json:"hasDisallowedAttributes"`
+}
+
+type AuditsStyleSheetLoadingIssueReason = string
+
+// AuditsStylesheetLoadingIssueDetails: This issue warns when a referenced stylesheet couldn't be loaded.
+type AuditsStylesheetLoadingIssueDetails struct {
+	// Source code position that referenced the failing stylesheet.
+	SourceCodeLocation AuditsSourceCodeLocation `json:"sourceCodeLocation"`
+	// Reason why the stylesheet couldn't be loaded.
+	StyleSheetLoadingIssueReason AuditsStyleSheetLoadingIssueReason `json:"styleSheetLoadingIssueReason"`
+	// Contains additional info when the failure was due to a request.
+	FailedRequestInfo *AuditsFailedRequestInfo `json:"failedRequestInfo,omitempty"`
+}
+
+type AuditsPropertyRuleIssueReason = string
+
+// AuditsPropertyRuleIssueDetails: This issue warns about errors in property rules that lead to property registrations being ignored.
+type AuditsPropertyRuleIssueDetails struct {
+	// Source code position of the property rule.
+	SourceCodeLocation AuditsSourceCodeLocation `json:"sourceCodeLocation"`
+	// Reason why the property rule was discarded.
+	PropertyRuleIssueReason AuditsPropertyRuleIssueReason `json:"propertyRuleIssueReason"`
+	// The value of the property rule property that failed to parse
+	PropertyValue string `json:"propertyValue,omitempty"`
+}
+
+type AuditsUserReidentificationIssueType = string
+
+// AuditsUserReidentificationIssueDetails: This issue warns about uses of APIs that may be considered misuse to re-identify users.
+type AuditsUserReidentificationIssueDetails struct {
+	Type AuditsUserReidentificationIssueType `json:"type"`
+	// Applies to BlockedFrameNavigation and BlockedSubresource issue types.
+	Request *AuditsAffectedRequest `json:"request,omitempty"`
+}
+
+// AuditsInspectorIssueCode: A unique identifier for the type of issue. Each type may use one of the optional fields in InspectorIssueDetails to convey more specific information about the kind of issue.
+type AuditsInspectorIssueCode = string
+
+// AuditsInspectorIssueDetails: This struct holds a list of optional fields with additional information specific to the kind of issue. When adding a new issue code, please also add a new optional field to this type.
+type AuditsInspectorIssueDetails struct {
+	CookieIssueDetails                       *AuditsCookieIssueDetails                       `json:"cookieIssueDetails,omitempty"`
+	MixedContentIssueDetails                 *AuditsMixedContentIssueDetails                 `json:"mixedContentIssueDetails,omitempty"`
+	BlockedByResponseIssueDetails            *AuditsBlockedByResponseIssueDetails            `json:"blockedByResponseIssueDetails,omitempty"`
+	HeavyAdIssueDetails                      *AuditsHeavyAdIssueDetails                      `json:"heavyAdIssueDetails,omitempty"`
+	ContentSecurityPolicyIssueDetails        *AuditsContentSecurityPolicyIssueDetails        `json:"contentSecurityPolicyIssueDetails,omitempty"`
+	SharedArrayBufferIssueDetails            *AuditsSharedArrayBufferIssueDetails            `json:"sharedArrayBufferIssueDetails,omitempty"`
+	LowTextContrastIssueDetails              *AuditsLowTextContrastIssueDetails              `json:"lowTextContrastIssueDetails,omitempty"`
+	CorsIssueDetails                         *AuditsCorsIssueDetails                         `json:"corsIssueDetails,omitempty"`
+	AttributionReportingIssueDetails         *AuditsAttributionReportingIssueDetails         `json:"attributionReportingIssueDetails,omitempty"`
+	QuirksModeIssueDetails                   *AuditsQuirksModeIssueDetails                   `json:"quirksModeIssueDetails,omitempty"`
+	PartitioningBlobURLIssueDetails          *AuditsPartitioningBlobURLIssueDetails          `json:"partitioningBlobURLIssueDetails,omitempty"`
+	NavigatorUserAgentIssueDetails           *AuditsNavigatorUserAgentIssueDetails           `json:"navigatorUserAgentIssueDetails,omitempty"`
+	GenericIssueDetails                      *AuditsGenericIssueDetails                      `json:"genericIssueDetails,omitempty"`
+	DeprecationIssueDetails                  *AuditsDeprecationIssueDetails                  `json:"deprecationIssueDetails,omitempty"`
+	ClientHintIssueDetails                   *AuditsClientHintIssueDetails                   `json:"clientHintIssueDetails,omitempty"`
+	FederatedAuthRequestIssueDetails         *AuditsFederatedAuthRequestIssueDetails         `json:"federatedAuthRequestIssueDetails,omitempty"`
+	BounceTrackingIssueDetails               *AuditsBounceTrackingIssueDetails               `json:"bounceTrackingIssueDetails,omitempty"`
+	CookieDeprecationMetadataIssueDetails    *AuditsCookieDeprecationMetadataIssueDetails    `json:"cookieDeprecationMetadataIssueDetails,omitempty"`
+	StylesheetLoadingIssueDetails            *AuditsStylesheetLoadingIssueDetails            `json:"stylesheetLoadingIssueDetails,omitempty"`
+	PropertyRuleIssueDetails                 *AuditsPropertyRuleIssueDetails                 `json:"propertyRuleIssueDetails,omitempty"`
+	FederatedAuthUserInfoRequestIssueDetails *AuditsFederatedAuthUserInfoRequestIssueDetails `json:"federatedAuthUserInfoRequestIssueDetails,omitempty"`
+	SharedDictionaryIssueDetails             *AuditsSharedDictionaryIssueDetails             `json:"sharedDictionaryIssueDetails,omitempty"`
+	ElementAccessibilityIssueDetails         *AuditsElementAccessibilityIssueDetails         `json:"elementAccessibilityIssueDetails,omitempty"`
+	SriMessageSignatureIssueDetails          *AuditsSRIMessageSignatureIssueDetails          `json:"sriMessageSignatureIssueDetails,omitempty"`
+	UnencodedDigestIssueDetails              *AuditsUnencodedDigestIssueDetails              `json:"unencodedDigestIssueDetails,omitempty"`
+	UserReidentificationIssueDetails         *AuditsUserReidentificationIssueDetails         `json:"userReidentificationIssueDetails,omitempty"`
+}
+
+// AuditsIssueID: A unique id for a DevTools inspector issue. Allows other entities (e.g. exceptions, CDP message, console messages, etc.) to reference an issue.
+type AuditsIssueID = string
+
+// AuditsInspectorIssue: An inspector issue reported from the back-end.
+type AuditsInspectorIssue struct {
+	Code    AuditsInspectorIssueCode    `json:"code"`
+	Details AuditsInspectorIssueDetails `json:"details"`
+	// A unique id for this issue. May be omitted if no other entity (e.g. exception, CDP message, etc.) is referencing this issue.
+	IssueID AuditsIssueID `json:"issueId,omitempty"`
+}
+
+// AuditsGetEncodedResponseParams are the parameters of Audits.getEncodedResponse. Returns the response body and size if it were re-encoded with the specified settings. Only applies to images.
+type AuditsGetEncodedResponseParams struct {
+	// Identifier of the network request to get content for.
+	RequestID NetworkRequestID `json:"requestId"`
+	// The encoding to use.
+	Encoding string `json:"encoding"`
+	// The quality of the encoding (0-1). (defaults to 1)
+	Quality float64 `json:"quality,omitempty"`
+	// Whether to only return the size information (defaults to false).
+	SizeOnly bool `json:"sizeOnly,omitempty"`
+}
+
+// AuditsGetEncodedResponseReturns is the result of Audits.getEncodedResponse.
+type AuditsGetEncodedResponseReturns struct {
+	// The encoded body as a base64 string. Omitted if sizeOnly is true. (Encoded as a base64 string when passed over JSON)
+	Body string `json:"body,omitempty"`
+	// Size before re-encoding.
+	OriginalSize int `json:"originalSize"`
+	// Size after re-encoding.
+	EncodedSize int `json:"encodedSize"`
+}
+
+// AuditsCheckContrastParams are the parameters of Audits.checkContrast. Runs the contrast check for the target page. Found issues are reported using Audits.issueAdded event.
+type AuditsCheckContrastParams struct {
+	// Whether to report WCAG AAA level issues. Default is false.
+	ReportAAA bool `json:"reportAAA,omitempty"`
+}
+
+// AuditsCheckFormsIssuesReturns is the result of Audits.checkFormsIssues.
+type AuditsCheckFormsIssuesReturns struct {
+	FormIssues []AuditsGenericIssueDetails `json:"formIssues"`
+}
+
+// AuditsIssueAddedEvent is the Audits.issueAdded event.
+type AuditsIssueAddedEvent struct {
+	Issue AuditsInspectorIssue `json:"issue"`
+}
@@ -0,0 +1,289 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package protocol
+
+// Browser domain: The Browser domain defines methods and events for browser managing.
+
+const (
+	CommandBrowserSetPermission                         = "Browser.setPermission"
+	CommandBrowserGrantPermissions                      = "Browser.grantPermissions"
+	CommandBrowserResetPermissions                      = "Browser.resetPermissions"
+	CommandBrowserSetDownloadBehavior                   = "Browser.setDownloadBehavior"
+	CommandBrowserCancelDownload                        = "Browser.cancelDownload"
+	CommandBrowserClose                                 = "Browser.close"
+	CommandBrowserCrash                                 = "Browser.crash"
+	CommandBrowserCrashGpuProcess                       = "Browser.crashGpuProcess"
+	CommandBrowserGetVersion                            = "Browser.getVersion"
+	CommandBrowserGetBrowserCommandLine                 = "Browser.getBrowserCommandLine"
+	CommandBrowserGetHistograms                         = "Browser.getHistograms"
+	CommandBrowserGetHistogram                          = "Browser.getHistogram"
+	CommandBrowserGetWindowBounds                       = "Browser.getWindowBounds"
+	CommandBrowserGetWindowForTarget                    = "Browser.getWindowForTarget"
+	CommandBrowserSetWindowBounds                       = "Browser.setWindowBounds"
+	CommandBrowserSetContentsSize                       = "Browser.setContentsSize"
+	CommandBrowserSetDockTile                           = "Browser.setDockTile"
+	CommandBrowserExecuteBrowserCommand                 = "Browser.executeBrowserCommand"
+	CommandBrowserAddPrivacySandboxEnrollmentOverride   = "Browser.addPrivacySandboxEnrollmentOverride"
+	CommandBrowserAddPrivacySandboxCoordinatorKeyConfig = "Browser.addPrivacySandboxCoordinatorKeyConfig"
+	EventBrowserDownloadWillBegin                       = "Browser.downloadWillBegin"
+	EventBrowserDownloadProgress                        = "Browser.downloadProgress"
+)
+
+type BrowserBrowserContextID = string
+
+type BrowserWindowID = int
+
+// BrowserWindowState: The state of the browser window.
+type BrowserWindowState = string
+
+// BrowserBounds: Browser window bounds information
+type BrowserBounds struct {
+	// The offset from the left edge of the screen to the window in pixels.
+	Left int `json:"left,omitempty"`
+	// The offset from the top edge of the screen to the window in pixels.
+	Top int `json:"top,omitempty"`
+	// The window width in pixels.
+	Width int `json:"width,omitempty"`
+	// The window height in pixels.
+	Height int `json:"height,omitempty"`
+	// The window state. Default to normal.
+	WindowState BrowserWindowState `json:"windowState,omitempty"`
+}
+
+type BrowserPermissionType = string
+
+type BrowserPermissionSetting = string
+
+// BrowserPermissionDescriptor: Definition of PermissionDescriptor defined in the Permissions API: https://w3c.github.io/permissions/#dom-permissiondescriptor.
+type BrowserPermissionDescriptor struct {
+	// Name of permission. See https://cs.chromium.org/chromium/src/third_party/blink/renderer/modules/permissions/permission_descriptor.idl for valid permission names.
+	Name string `json:"name"`
+	// For "midi" permission, may also specify sysex control.
+	Sysex bool `json:"sysex,omitempty"`
+	// For "push" permission, may specify userVisibleOnly. Note that userVisibleOnly = true is the only currently supported type.
+	UserVisibleOnly bool `json:"userVisibleOnly,omitempty"`
+	// For "clipboard" permission, may specify allowWithoutSanitization.
+	AllowWithoutSanitization bool `json:"allowWithoutSanitization,omitempty"`
+	// For "fullscreen" permission, must specify allowWithoutGesture:true.
+	AllowWithoutGesture bool `json:"allowWithoutGesture,omitempty"`
+	// For "camera" permission, may specify panTiltZoom.
+	PanTiltZoom bool `json:"panTiltZoom,omitempty"`
+}
+
+// BrowserBrowserCommandID: Browser command ids used by executeBrowserCommand.
+type BrowserBrowserCommandID = string
+
+// BrowserBucket: Chrome histogram bucket.
+type BrowserBucket struct {
+	// Minimum value (inclusive).
+	Low int `json:"low"`
+	// Maximum value (exclusive).
+	High int `json:"high"`
+	// Number of samples.
+	Count int `json:"count"`
+}
+
+// BrowserHistogram: Chrome histogram.
+type BrowserHistogram struct {
+	// Name.
+	Name string `json:"name"`
+	// Sum of sample values.
+	Sum int `json:"sum"`
+	// Total number of samples.
+	Count int `json:"count"`
+	// Buckets.
+	Buckets []BrowserBucket `json:"buckets"`
+}
+
+type BrowserPrivacySandboxAPI = string
+
+// BrowserSetPermissionParams are the parameters of Browser.setPermission. Set permission settings for given origin.
+type BrowserSetPermissionParams struct {
+	// Descriptor of permission to override.
+	Permission BrowserPermissionDescriptor `json:"permission"`
+	// Setting of the permission.
+	Setting BrowserPermissionSetting `json:"setting"`
+	// Origin the permission applies to, all origins if not specified.
+	Origin string `json:"origin,omitempty"`
+	// Context to override. When omitted, default browser context is used.
+	BrowserContextID BrowserBrowserContextID `json:"browserContextId,omitempty"`
+}
+
+// BrowserGrantPermissionsParams are the parameters of Browser.grantPermissions. Grant specific permissions to the given origin and reject all others.
+type BrowserGrantPermissionsParams struct {
+	Permissions []BrowserPermissionType `json:"permissions"`
+	// Origin the permission applies to, all origins if not specified.
+	Origin string `json:"origin,omitempty"`
+	// BrowserContext to override permissions. When omitted, default browser context is used.
+	BrowserContextID BrowserBrowserContextID `json:"browserContextId,omitempty"`
+}
+
+// BrowserResetPermissionsParams are the parameters of Browser.resetPermissions. Reset all permission management for all origins.
+type BrowserResetPermissionsParams struct {
+	// BrowserContext to reset permissions. When omitted, default browser context is used.
+	BrowserContextID BrowserBrowserContextID `json:"browserContextId,omitempty"`
+}
+
+// BrowserSetDownloadBehaviorParams are the parameters of Browser.setDownloadBehavior. Set the behavior when downloading a file.
+type BrowserSetDownloadBehaviorParams struct {
+	// Whether to allow all or deny all download requests, or use default Chrome behavior if available (otherwise deny). |allowAndName| allows download and names files according to their download guids.
+	Behavior string `json:"behavior"`
+	// BrowserContext to set download behavior. When omitted, default browser context is used.
+	BrowserContextID BrowserBrowserContextID `json:"browserContextId,omitempty"`
+	// The default path to save downloaded files to. This is required if behavior is set to 'allow' or 'allowAndName'.
+	DownloadPath string `json:"downloadPath,omitempty"`
+	// Whether to emit download events (defaults to false).
+	EventsEnabled bool `json:"eventsEnabled,omitempty"`
+}
+
+// BrowserCancelDownloadParams are the parameters of Browser.cancelDownload. Cancel a download if in progress
+type BrowserCancelDownloadParams struct {
+	// Global unique identifier of the download.
+	Guid string `json:"guid"`
+	// BrowserContext to perform the action in. When omitted, default browser context is used.
+	BrowserContextID BrowserBrowserContextID `json:"browserContextId,omitempty"`
+}
+
+// BrowserGetVersionReturns is the result of Browser.getVersion.
+type BrowserGetVersionReturns struct {
+	// Protocol version.
+	ProtocolVersion string `json:"protocolVersion"`
+	// Product name.
+	Product string `json:"product"`
+	// Product revision.
+	Revision string `json:"revision"`
+	// User-Agent.
+	UserAgent string `json:"userAgent"`
+	// V8 version.
+	JSVersion string `json:"jsVersion"`
+}
+
+// BrowserGetBrowserCommandLineReturns is the result of Browser.getBrowserCommandLine.
+type BrowserGetBrowserCommandLineReturns struct {
+	// Commandline parameters
+	Arguments []string `json:"arguments"`
+}
+
+// BrowserGetHistogramsParams are the parameters of Browser.getHistograms. Get Chrome histograms.
+type BrowserGetHistogramsParams struct {
+	// Requested substring in name. Only histograms which have query as a substring in their name are extracted. An empty or absent query returns all histograms.
+	Query string `json:"query,omitempty"`
+	// If true, retrieve delta since last delta call.
+	Delta bool `json:"delta,omitempty"`
+}
+
+// BrowserGetHistogramsReturns is the result of Browser.getHistograms.
+type BrowserGetHistogramsReturns struct {
+	// Histograms.
+	Histograms []BrowserHistogram `json:"histograms"`
+}
+
+// BrowserGetHistogramParams are the parameters of Browser.getHistogram. Get a Chrome histogram by name.
+type BrowserGetHistogramParams struct {
+	// Requested histogram name.
+	Name string `json:"name"`
+	// If true, retrieve delta since last delta call.
+	Delta bool `json:"delta,omitempty"`
+}
+
+// BrowserGetHistogramReturns is the result of Browser.getHistogram.
+type BrowserGetHistogramReturns struct {
+	// Histogram.
+	Histogram BrowserHistogram `json:"histogram"`
+}
+
+// BrowserGetWindowBoundsParams are the parameters of Browser.getWindowBounds. Get position and size of the browser window.
+type BrowserGetWindowBoundsParams struct {
+	// Browser window id.
+	WindowID BrowserWindowID `json:"windowId"`
+}
+
+// BrowserGetWindowBoundsReturns is the result of Browser.getWindowBounds.
+type BrowserGetWindowBoundsReturns struct {
+	// Bounds information of the window. When window state is 'minimized', the restored window position and size are returned.
+	Bounds BrowserBounds `json:"bounds"`
+}
+
+// BrowserGetWindowForTargetParams are the parameters of Browser.getWindowForTarget. Get the browser window that contains the devtools target.
+type BrowserGetWindowForTargetParams struct {
+	// Devtools agent host id. If called as a part of the session, associated targetId is used.
+	TargetID TargetTargetID `json:"targetId,omitempty"`
+}
+
+// BrowserGetWindowForTargetReturns is the result of Browser.getWindowForTarget.
+type BrowserGetWindowForTargetReturns struct {
+	// Browser window id.
+	WindowID BrowserWindowID `json:"windowId"`
+	// Bounds information of the window. When window state is 'minimized', the restored window position and size are returned.
+	Bounds BrowserBounds `json:"bounds"`
+}
+
+// BrowserSetWindowBoundsParams are the parameters of Browser.setWindowBounds. Set position and/or size of the browser window.
+type BrowserSetWindowBoundsParams struct {
+	// Browser window id.
+	WindowID BrowserWindowID `json:"windowId"`
+	// New window bounds. The 'minimized', 'maximized' and 'fullscreen' states cannot be combined with 'left', 'top', 'width' or 'height'. Leaves unspecified fields unchanged.
+	Bounds BrowserBounds `json:"bounds"`
+}
+
+// BrowserSetContentsSizeParams are the parameters of Browser.setContentsSize. Set size of the browser contents resizing browser window as necessary.
+type BrowserSetContentsSizeParams struct {
+	// Browser window id.
+	WindowID BrowserWindowID `json:"windowId"`
+	// The window contents width in DIP. Assumes current width if omitted. Must be specified if 'height' is omitted.
+	Width int `json:"width,omitempty"`
+	// The window contents height in DIP. Assumes current height if omitted. Must be specified if 'width' is omitted.
+	Height int `json:"height,omitempty"`
+}
+
+// BrowserSetDockTileParams are the parameters of Browser.setDockTile. Set dock tile details, platform-specific.
+type BrowserSetDockTileParams struct {
+	BadgeLabel string `json:"badgeLabel,omitempty"`
+	// Png encoded image. (Encoded as a base64 string when passed over JSON)
+	Image string `json:"image,omitempty"`
+}
+
+// BrowserExecuteBrowserCommandParams are the parameters of Browser.executeBrowserCommand. Invoke custom browser commands used by telemetry.
+type BrowserExecuteBrowserCommandParams struct {
+	CommandID BrowserBrowserCommandID `json:"commandId"`
+}
+
+// BrowserAddPrivacySandboxEnrollmentOverrideParams are the parameters of Browser.addPrivacySandboxEnrollmentOverride. Allows a site to use privacy sandbox features that require enrollment without the site actually being enrolled. Only supported on page targets.
+type BrowserAddPrivacySandboxEnrollmentOverrideParams struct {
+	URL string `json:"url"`
+}
+
+// BrowserAddPrivacySandboxCoordinatorKeyConfigParams are the parameters of Browser.addPrivacySandboxCoordinatorKeyConfig. Configures encryption keys used with a given privacy sandbox API to talk to a trusted coordinator. Since this is intended for test automation only, coordinatorOrigin must be a .test domain. No existing coordinator configuration for the origin may exist.
+type BrowserAddPrivacySandboxCoordinatorKeyConfigParams struct {
+	API               BrowserPrivacySandboxAPI `json:"api"`
+	CoordinatorOrigin string                   `json:"coordinatorOrigin"`
+	KeyConfig         string                   `json:"keyConfig"`
+	// BrowserContext to perform the action in. When omitted, default browser context is used.
+	BrowserContextID BrowserBrowserContextID `json:"browserContextId,omitempty"`
+}
+
+// BrowserDownloadWillBeginEvent is the Browser.downloadWillBegin event. Fired when page is about to start a download.
+type BrowserDownloadWillBeginEvent struct {
+	// Id of the frame that caused the download to begin.
+	FrameID PageFrameID `json:"frameId"`
+	// Global unique identifier of the download.
+	Guid string `json:"guid"`
+	// URL of the resource being downloaded.
+	URL string `json:"url"`
+	// Suggested file name of the resource (the actual name of the file saved on disk may differ).
+	SuggestedFilename string `json:"suggestedFilename"`
+}
+
+// BrowserDownloadProgressEvent is the Browser.downloadProgress event. Fired when download makes progress. Last call has |done| == true.
+type BrowserDownloadProgressEvent struct {
+	// Global unique identifier of the download.
+	Guid string `json:"guid"`
+	// Total expected bytes to download.
+	TotalBytes float64 `json:"totalBytes"`
+	// Total bytes received.
+	ReceivedBytes float64 `json:"receivedBytes"`
+	// Download status.
+	State string `json:"state"`
+	// If download is "completed", provides the path of the downloaded file. Depending on the platform, it is not guaranteed to be set, nor the file is guaranteed to exist.
+	FilePath string `json:"filePath,omitempty"`
+}
@@ -0,0 +1,34 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package protocol
+
+// Console domain: This domain is deprecated - use Runtime or Log instead.
+
+const (
+	CommandConsoleClearMessages = "Console.clearMessages"
+	CommandConsoleDisable       = "Console.disable"
+	CommandConsoleEnable        = "Console.enable"
+	EventConsoleMessageAdded    = "Console.messageAdded"
+)
+
+// ConsoleConsoleMessage: Console message.
+type ConsoleConsoleMessage struct {
+	// Message source.
+	Source string `json:"source"`
+	// Message severity.
+	Level string `json:"level"`
+	// Message text.
+	Text string `json:"text"`
+	// URL of the message origin.
+	URL string `json:"url,omitempty"`
+	// Line number in the resource that generated this message (1-based).
+	Line int `json:"line,omitempty"`
+	// Column number in the resource that generated this message (1-based).
+	Column int `json:"column,omitempty"`
+}
+
+// ConsoleMessageAddedEvent is the Console.messageAdded event. Issued when new console message is added.
+type ConsoleMessageAddedEvent struct {
+	// Console message that has been added.
+	Message ConsoleConsoleMessage `json:"message"`
+}
@@ -0,0 +1,627 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package protocol
+
+import (
+	"encoding/json"
+)
+
+// Debugger domain: Debugger domain exposes JavaScript debugging capabilities. It allows setting and removing breakpoints, stepping through execution, exploring stack traces, etc.
+
+const (
+	CommandDebuggerContinueToLocation           = "Debugger.continueToLocation"
+	CommandDebuggerDisable                      = "Debugger.disable"
+	CommandDebuggerEnable                       = "Debugger.enable"
+	CommandDebuggerEvaluateOnCallFrame          = "Debugger.evaluateOnCallFrame"
+	CommandDebuggerGetPossibleBreakpoints       = "Debugger.getPossibleBreakpoints"
+	CommandDebuggerGetScriptSource              = "Debugger.getScriptSource"
+	CommandDebuggerDisassembleWasmModule        = "Debugger.disassembleWasmModule"
+	CommandDebuggerNextWasmDisassemblyChunk     = "Debugger.nextWasmDisassemblyChunk"
+	CommandDebuggerGetWasmBytecode              = "Debugger.getWasmBytecode"
+	CommandDebuggerGetStackTrace                = "Debugger.getStackTrace"
+	CommandDebuggerPause                        = "Debugger.pause"
+	CommandDebuggerPauseOnAsyncCall             = "Debugger.pauseOnAsyncCall"
+	CommandDebuggerRemoveBreakpoint             = "Debugger.removeBreakpoint"
+	CommandDebuggerRestartFrame                 = "Debugger.restartFrame"
+	CommandDebuggerResume                       = "Debugger.resume"
+	CommandDebuggerSearchInContent              = "Debugger.searchInContent"
+	CommandDebuggerSetAsyncCallStackDepth       = "Debugger.setAsyncCallStackDepth"
+	CommandDebuggerSetBlackboxExecutionContexts = "Debugger.setBlackboxExecutionContexts"
+	CommandDebuggerSetBlackboxPatterns          = "Debugger.setBlackboxPatterns"
+	CommandDebuggerSetBlackboxedRanges          = "Debugger.setBlackboxedRanges"
+	CommandDebuggerSetBreakpoint                = "Debugger.setBreakpoint"
+	CommandDebuggerSetInstrumentationBreakpoint = "Debugger.setInstrumentationBreakpoint"
+	CommandDebuggerSetBreakpointByURL           = "Debugger.setBreakpointByUrl"
+	CommandDebuggerSetBreakpointOnFunctionCall  = "Debugger.setBreakpointOnFunctionCall"
+	CommandDebuggerSetBreakpointsActive         = "Debugger.setBreakpointsActive"
+	CommandDebuggerSetPauseOnExceptions         = "Debugger.setPauseOnExceptions"
+	CommandDebuggerSetReturnValue               = "Debugger.setReturnValue"
+	CommandDebuggerSetScriptSource              = "Debugger.setScriptSource"
+	CommandDebuggerSetSkipAllPauses             = "Debugger.setSkipAllPauses"
+	CommandDebuggerSetVariableValue             = "Debugger.setVariableValue"
+	CommandDebuggerStepInto                     = "Debugger.stepInto"
+	CommandDebuggerStepOut                      = "Debugger.stepOut"
+	CommandDebuggerStepOver                     = "Debugger.stepOver"
+	EventDebuggerBreakpointResolved             = "Debugger.breakpointResolved"
+	EventDebuggerPaused                         = "Debugger.paused"
+	EventDebuggerResumed                        = "Debugger.resumed"
+	EventDebuggerScriptFailedToParse            = "Debugger.scriptFailedToParse"
+	EventDebuggerScriptParsed                   = "Debugger.scriptParsed"
+)
+
+// DebuggerBreakpointID: Breakpoint identifier.
+type DebuggerBreakpointID = string
+
+// DebuggerCallFrameID: Call frame identifier.
+type DebuggerCallFrameID = string
+
+// DebuggerLocation: Location in the source code.
+type DebuggerLocation struct {
+	// Script identifier as reported in the `Debugger.scriptParsed`.
+	ScriptID RuntimeScriptID `json:"scriptId"`
+	// Line number in the script (0-based).
+	LineNumber int `json:"lineNumber"`
+	// Column number in the script (0-based).
+	ColumnNumber int `json:"columnNumber,omitempty"`
+}
+
+// DebuggerScriptPosition: Location in the source code.
+type DebuggerScriptPosition struct {
+	LineNumber   int `json:"lineNumber"`
+	ColumnNumber int `json:"columnNumber"`
+}
+
+// DebuggerLocationRange: Location range within one script.
+type DebuggerLocationRange struct {
+	ScriptID RuntimeScriptID        `json:"scriptId"`
+	Start    DebuggerScriptPosition `json:"start"`
+	End      DebuggerScriptPosition `json:"end"`
+}
+
+// DebuggerCallFrame: JavaScript call frame. Array of call frames form the call stack.
+type DebuggerCallFrame struct {
+	// Call frame identifier. This identifier is only valid while the virtual machine is paused.
+	CallFrameID DebuggerCallFrameID `json:"callFrameId"`
+	// Name of the JavaScript function called on this call frame.
+	FunctionName string `json:"functionName"`
+	// Location in the source code.
+	FunctionLocation *DebuggerLocation `json:"functionLocation,omitempty"`
+	// Location in the source code.
+	Location DebuggerLocation `json:"location"`
+	// JavaScript script name or url. Deprecated in favor of using the `location.scriptId` to resolve the URL via a previously sent `Debugger.scriptParsed` event.
+	URL string `json:"url"`
+	// Scope chain for this call frame.
+	ScopeChain []DebuggerScope `json:"scopeChain"`
+	// `this` object for this call frame.
+	This RuntimeRemoteObject `json:"this"`
+	// The value being returned, if the function is at return point.
+	ReturnValue *RuntimeRemoteObject `json:"returnValue,omitempty"`
+	// Valid only while the VM is paused and indicates whether this frame can be restarted or not. Note that a `true` value here does not guarantee that Debugger#restartFrame with this CallFrameId will be successful, but it is very likely.
+	CanBeRestarted bool `json:"canBeRestarted,omitempty"`
+}
+
+// DebuggerScope: Scope description.
+type DebuggerScope struct {
+	// Scope type.
+	Type string `json:"type"`
+	// Object representing the scope. For `global` and `with` scopes it represents the actual object; for the rest of the scopes, it is artificial transient object enumerating scope variables as its properties.
+	Object RuntimeRemoteObject `json:"object"`
+	Name   string              `json:"name,omitempty"`
+	// Location in the source code where scope starts
+	StartLocation *DebuggerLocation `json:"startLocation,omitempty"`
+	// Location in the source code where scope ends
+	EndLocation *DebuggerLocation `json:"endLocation,omitempty"`
+}
+
+// DebuggerSearchMatch: Search match for resource.
+type DebuggerSearchMatch struct {
+	// Line number in resource content.
+	LineNumber float64 `json:"lineNumber"`
+	// Line with match content.
+	LineContent string `json:"lineContent"`
+}
+
+type DebuggerBreakLocation struct {
+	// Script identifier as reported in the `Debugger.scriptParsed`.
+	ScriptID RuntimeScriptID `json:"scriptId"`
+	// Line number in the script (0-based).
+	LineNumber int `json:"lineNumber"`
+	// Column number in the script (0-based).
+	ColumnNumber int    `json:"columnNumber,omitempty"`
+	Type         string `json:"type,omitempty"`
+}
+
+type DebuggerWasmDisassemblyChunk struct {
+	// The next chunk of disassembled lines.
+	Lines []string `json:"lines"`
+	// The bytecode offsets describing the start of each line.
+	BytecodeOffsets []int `json:"bytecodeOffsets"`
+}
+
+// DebuggerScriptLanguage: Enum of possible script languages.
+type DebuggerScriptLanguage = string
+
+// DebuggerDebugSymbols: Debug symbols available for a wasm script.
+type DebuggerDebugSymbols struct {
+	// Type of the debug symbols.
+	Type string `json:"type"`
+	// URL of the external symbol source.
+	ExternalURL string `json:"externalURL,omitempty"`
+}
+
+type DebuggerResolvedBreakpoint struct {
+	// Breakpoint unique identifier.
+	BreakpointID DebuggerBreakpointID `json:"breakpointId"`
+	// Actual breakpoint location.
+	Location DebuggerLocation `json:"location"`
+}
+
+// DebuggerContinueToLocationParams are the parameters of Debugger.continueToLocation. Continues execution until specific location is reached.
+type DebuggerContinueToLocationParams struct {
+	// Location to continue to.
+	Location         DebuggerLocation `json:"location"`
+	TargetCallFrames string           `json:"targetCallFrames,omitempty"`
+}
+
+// DebuggerEnableParams are the parameters of Debugger.enable. Enables debugger for the given page. Clients should not assume that the debugging has been enabled until the result for this command is received.
+type DebuggerEnableParams struct {
+	// The maximum size in bytes of collected scripts (not referenced by other heap objects) the debugger can hold. Puts no limit if parameter is omitted.
+	MaxScriptsCacheSize float64 `json:"maxScriptsCacheSize,omitempty"`
+}
+
+// DebuggerEnableReturns is the result of Debugger.enable.
+type DebuggerEnableReturns struct {
+	// Unique identifier of the debugger.
+	DebuggerID RuntimeUniqueDebuggerID `json:"debuggerId"`
+}
+
+// DebuggerEvaluateOnCallFrameParams are the parameters of Debugger.evaluateOnCallFrame. Evaluates expression on a given call frame.
+type DebuggerEvaluateOnCallFrameParams struct {
+	// Call frame identifier to evaluate on.
+	CallFrameID DebuggerCallFrameID `json:"callFrameId"`
+	// Expression to evaluate.
+	Expression string `json:"expression"`
+	// String object group name to put result into (allows rapid releasing resulting object handles using `releaseObjectGroup`).
+	ObjectGroup string `json:"objectGroup,omitempty"`
+	// Specifies whether command line API should be available to the evaluated expression, defaults to false.
+	IncludeCommandLineAPI bool `json:"includeCommandLineAPI,omitempty"`
+	// In silent mode exceptions thrown during evaluation are not reported and do not pause execution. Overrides `setPauseOnException` state.
+	Silent bool `json:"silent,omitempty"`
+	// Whether the result is expected to be a JSON object that should be sent by value.
+	ReturnByValue bool `json:"returnByValue,omitempty"`
+	// Whether preview should be generated for the result.
+	GeneratePreview bool `json:"generatePreview,omitempty"`
+	// Whether to throw an exception if side effect cannot be ruled out during evaluation.
+	ThrowOnSideEffect bool `json:"throwOnSideEffect,omitempty"`
+	// Terminate execution after timing out (number of milliseconds).
+	Timeout RuntimeTimeDelta `json:"timeout,omitempty"`
+}
+
+// DebuggerEvaluateOnCallFrameReturns is the result of Debugger.evaluateOnCallFrame.
+type DebuggerEvaluateOnCallFrameReturns struct {
+	// Object wrapper for the evaluation result.
+	Result RuntimeRemoteObject `json:"result"`
+	// Exception details.
+	ExceptionDetails *RuntimeExceptionDetails `json:"exceptionDetails,omitempty"`
+}
+
+// DebuggerGetPossibleBreakpointsParams are the parameters of Debugger.getPossibleBreakpoints. Returns possible locations for breakpoint. scriptId in start and end range locations should be the same.
+type DebuggerGetPossibleBreakpointsParams struct {
+	// Start of range to search possible breakpoint locations in.
+	Start DebuggerLocation `json:"start"`
+	// End of range to search possible breakpoint locations in (excluding). When not specified, end of scripts is used as end of range.
+	End *DebuggerLocation `json:"end,omitempty"`
+	// Only consider locations which are in the same (non-nested) function as start.
+	RestrictToFunction bool `json:"restrictToFunction,omitempty"`
+}
+
+// DebuggerGetPossibleBreakpointsReturns is the result of Debugger.getPossibleBreakpoints.
+type DebuggerGetPossibleBreakpointsReturns struct {
+	// List of the possible breakpoint locations.
+	Locations []DebuggerBreakLocation `json:"locations"`
+}
+
+// DebuggerGetScriptSourceParams are the parameters of Debugger.getScriptSource. Returns source for the script with given id.
+type DebuggerGetScriptSourceParams struct {
+	// Id of the script to get source for.
+	ScriptID RuntimeScriptID `json:"scriptId"`
+}
+
+// DebuggerGetScriptSourceReturns is the result of Debugger.getScriptSource.
+type DebuggerGetScriptSourceReturns struct {
+	// Script source (empty in case of Wasm bytecode).
+	ScriptSource string `json:"scriptSource"`
+	// Wasm bytecode. (Encoded as a base64 string when passed over JSON)
+	Bytecode string `json:"bytecode,omitempty"`
+}
+
+// DebuggerDisassembleWasmModuleParams are the parameters of Debugger.disassembleWasmModule.
+type DebuggerDisassembleWasmModuleParams struct {
+	// Id of the script to disassemble
+	ScriptID RuntimeScriptID `json:"scriptId"`
+}
+
+// DebuggerDisassembleWasmModuleReturns is the result of Debugger.disassembleWasmModule.
+type DebuggerDisassembleWasmModuleReturns struct {
+	// For large modules, return a stream from which additional chunks of disassembly can be read successively.
+	StreamID string `json:"streamId,omitempty"`
+	// The total number of lines in the disassembly text.
+	TotalNumberOfLines int `json:"totalNumberOfLines"`
+	// The offsets of all function bodies, in the format [start1, end1, start2, end2, ...] where all ends are exclusive.
+	FunctionBodyOffsets []int `json:"functionBodyOffsets"`
+	// The first chunk of disassembly.
+	Chunk DebuggerWasmDisassemblyChunk `json:"chunk"`
+}
+
+// DebuggerNextWasmDisassemblyChunkParams are the parameters of Debugger.nextWasmDisassemblyChunk. Disassemble the next chunk of lines for the module corresponding to the stream. If disassembly is complete, this API will invalidate the streamId and return an empty chunk. Any subsequent calls for the now invalid stream will return errors.
+type DebuggerNextWasmDisassemblyChunkParams struct {
+	StreamID string `json:"streamId"`
+}
+
+// DebuggerNextWasmDisassemblyChunkReturns is the result of Debugger.nextWasmDisassemblyChunk.
+type DebuggerNextWasmDisassemblyChunkReturns struct {
+	// The next chunk of disassembly.
+	Chunk DebuggerWasmDisassemblyChunk `json:"chunk"`
+}
+
+// DebuggerGetWasmBytecodeParams are the parameters of Debugger.getWasmBytecode. This command is deprecated. Use getScriptSource instead.
+type DebuggerGetWasmBytecodeParams struct {
+	// Id of the Wasm script to get source for.
+	ScriptID RuntimeScriptID `json:"scriptId"`
+}
+
+// DebuggerGetWasmBytecodeReturns is the result of Debugger.getWasmBytecode.
+type DebuggerGetWasmBytecodeReturns struct {
+	// Script source. (Encoded as a base64 string when passed over JSON)
+	Bytecode string `json:"bytecode"`
+}
+
+// DebuggerGetStackTraceParams are the parameters of Debugger.getStackTrace. Returns stack trace with given `stackTraceId`.
+type DebuggerGetStackTraceParams struct {
+	StackTraceID RuntimeStackTraceID `json:"stackTraceId"`
+}
+
+// DebuggerGetStackTraceReturns is the result of Debugger.getStackTrace.
+type DebuggerGetStackTraceReturns struct {
+	StackTrace RuntimeStackTrace `json:"stackTrace"`
+}
+
+// DebuggerPauseOnAsyncCallParams are the parameters of Debugger.pauseOnAsyncCall.
+type DebuggerPauseOnAsyncCallParams struct {
+	// Debugger will pause when async call with given stack trace is started.
+	ParentStackTraceID RuntimeStackTraceID `json:"parentStackTraceId"`
+}
+
+// DebuggerRemoveBreakpointParams are the parameters of Debugger.removeBreakpoint. Removes JavaScript breakpoint.
+type DebuggerRemoveBreakpointParams struct {
+	BreakpointID DebuggerBreakpointID `json:"breakpointId"`
+}
+
+// DebuggerRestartFrameParams are the parameters of Debugger.restartFrame. Restarts particular call frame from the beginning. The old, deprecated behavior of `restartFrame` is to stay paused and allow further CDP commands after a restart was scheduled. This can cause problems with restarting, so we now continue execution immediatly after it has been scheduled until we reach the beginning of the restarted frame. To stay back-wards compatible, `restartFrame` now expects a `mode` parameter to be present. If the `mode` parameter is missing, `restartFrame` errors out. The various return values are deprecated and `callFrames` is always empty. Use the call frames from the `Debugger#paused` events instead, that fires once V8 pauses at the beginning of the restarted function.
+type DebuggerRestartFrameParams struct {
+	// Call frame identifier to evaluate on.
+	CallFrameID DebuggerCallFrameID `json:"callFrameId"`
+	// The `mode` parameter must be present and set to 'StepInto', otherwise `restartFrame` will error out.
+	Mode string `json:"mode,omitempty"`
+}
+
+// DebuggerRestartFrameReturns is the result of Debugger.restartFrame.
+type DebuggerRestartFrameReturns struct {
+	// New stack trace.
+	CallFrames []DebuggerCallFrame `json:"callFrames"`
+	// Async stack trace, if any.
+	AsyncStackTrace *RuntimeStackTrace `json:"asyncStackTrace,omitempty"`
+	// Async stack trace, if any.
+	AsyncStackTraceID *RuntimeStackTraceID `json:"asyncStackTraceId,omitempty"`
+}
+
+// DebuggerResumeParams are the parameters of Debugger.resume. Resumes JavaScript execution.
+type DebuggerResumeParams struct {
+	// Set to true to terminate execution upon resuming execution. In contrast to Runtime.terminateExecution, this will allows to execute further JavaScript (i.e. via evaluation) until execution of the paused code is actually resumed, at which point termination is triggered. If execution is currently not paused, this parameter has no effect.
+	TerminateOnResume bool `json:"terminateOnResume,omitempty"`
+}
+
+// DebuggerSearchInContentParams are the parameters of Debugger.searchInContent. Searches for given string in script content.
+type DebuggerSearchInContentParams struct {
+	// Id of the script to search in.
+	ScriptID RuntimeScriptID `json:"scriptId"`
+	// String to search for.
+	Query string `json:"query"`
+	// If true, search is case sensitive.
+	CaseSensitive bool `json:"caseSensitive,omitempty"`
+	// If true, treats string parameter as regex.
+	IsRegex bool `json:"isRegex,omitempty"`
+}
+
+// DebuggerSearchInContentReturns is the result of Debugger.searchInContent.
+type DebuggerSearchInContentReturns struct {
+	// List of search matches.
+	Result []DebuggerSearchMatch `json:"result"`
+}
+
+// DebuggerSetAsyncCallStackDepthParams are the parameters of Debugger.setAsyncCallStackDepth. Enables or disables async call stacks tracking.
+type DebuggerSetAsyncCallStackDepthParams struct {
+	// Maximum depth of async call stacks. Setting to `0` will effectively disable collecting async call stacks (default).
+	MaxDepth int `json:"maxDepth"`
+}
+
+// DebuggerSetBlackboxExecutionContextsParams are the parameters of Debugger.setBlackboxExecutionContexts. Replace previous blackbox execution contexts with passed ones. Forces backend to skip stepping/pausing in scripts in these execution contexts. VM will try to leave blackboxed script by performing 'step in' several times, finally resorting to 'step out' if unsuccessful.
+type DebuggerSetBlackboxExecutionContextsParams struct {
+	// Array of execution context unique ids for the debugger to ignore.
+	UniqueIds []string `json:"uniqueIds"`
+}
+
+// DebuggerSetBlackboxPatternsParams are the parameters of Debugger.setBlackboxPatterns. Replace previous blackbox patterns with passed ones. Forces backend to skip stepping/pausing in scripts with url matching one of the patterns. VM will try to leave blackboxed script by performing 'step in' several times, finally resorting to 'step out' if unsuccessful.
+type DebuggerSetBlackboxPatternsParams struct {
+	// Array of regexps that will be used to check script url for blackbox state.
+	Patterns []string `json:"patterns"`
+	// If true, also ignore scripts with no source url.
+	SkipAnonymous bool `json:"skipAnonymous,omitempty"`
+}
+
+// DebuggerSetBlackboxedRangesParams are the parameters of Debugger.setBlackboxedRanges. Makes backend skip steps in the script in blackboxed ranges. VM will try leave blacklisted scripts by performing 'step in' several times, finally resorting to 'step out' if unsuccessful. Positions array contains positions where blackbox state is changed. First interval isn't blackboxed. Array should be sorted.
+type DebuggerSetBlackboxedRangesParams struct {
+	// Id of the script.
+	ScriptID  RuntimeScriptID          `json:"scriptId"`
+	Positions []DebuggerScriptPosition `json:"positions"`
+}
+
+// DebuggerSetBreakpointParams are the parameters of Debugger.setBreakpoint. Sets JavaScript breakpoint at a given location.
+type DebuggerSetBreakpointParams struct {
+	// Location to set breakpoint in.
+	Location DebuggerLocation `json:"location"`
+	// Expression to use as a breakpoint condition. When specified, debugger will only stop on the breakpoint if this expression evaluates to true.
+	Condition string `json:"condition,omitempty"`
+}
+
+// DebuggerSetBreakpointReturns is the result of Debugger.setBreakpoint.
+type DebuggerSetBreakpointReturns struct {
+	// Id of the created breakpoint for further reference.
+	BreakpointID DebuggerBreakpointID `json:"breakpointId"`
+	// Location this breakpoint resolved into.
+	ActualLocation DebuggerLocation `json:"actualLocation"`
+}
+
+// DebuggerSetInstrumentationBreakpointParams are the parameters of Debugger.setInstrumentationBreakpoint. Sets instrumentation breakpoint.
+type DebuggerSetInstrumentationBreakpointParams struct {
+	// Instrumentation name.
+	Instrumentation string `json:"instrumentation"`
+}
+
+// DebuggerSetInstrumentationBreakpointReturns is the result of Debugger.setInstrumentationBreakpoint.
+type DebuggerSetInstrumentationBreakpointReturns struct {
+	// Id of the created breakpoint for further reference.
+	BreakpointID DebuggerBreakpointID `json:"breakpointId"`
+}
+
+// DebuggerSetBreakpointByURLParams are the parameters of Debugger.setBreakpointByUrl. Sets JavaScript breakpoint at given location specified either by URL or URL regex. Once this command is issued, all existing parsed scripts will have breakpoints resolved and returned in `locations` property. Further matching script parsing will result in subsequent `breakpointResolved` events issued. This logical breakpoint will survive page reloads.
+type DebuggerSetBreakpointByURLParams struct {
+	// Line number to set breakpoint at.
+	LineNumber int `json:"lineNumber"`
+	// URL of the resources to set breakpoint on.
+	URL string `json:"url,omitempty"`
+	// Regex pattern for the URLs of the resources to set breakpoints on. Either `url` or `urlRegex` must be specified.
+	URLRegex string `json:"urlRegex,omitempty"`
+	// Script hash of the resources to set breakpoint on.
+	ScriptHash string `json:"scriptHash,omitempty"`
+	// Offset in the line to set breakpoint at.
+	ColumnNumber int `json:"columnNumber,omitempty"`
+	// Expression to use as a breakpoint condition. When specified, debugger will only stop on the breakpoint if this expression evaluates to true.
+	Condition string `json:"condition,omitempty"`
+}
+
+// DebuggerSetBreakpointByURLReturns is the result of Debugger.setBreakpointByUrl.
+type DebuggerSetBreakpointByURLReturns struct {
+	// Id of the created breakpoint for further reference.
+	BreakpointID DebuggerBreakpointID `json:"breakpointId"`
+	// List of the locations this breakpoint resolved into upon addition.
+	Locations []DebuggerLocation `json:"locations"`
+}
+
+// DebuggerSetBreakpointOnFunctionCallParams are the parameters of Debugger.setBreakpointOnFunctionCall. Sets JavaScript breakpoint before each call to the given function. If another function was created from the same source as a given one, calling it will also trigger the breakpoint.
+type DebuggerSetBreakpointOnFunctionCallParams struct {
+	// Function object id.
+	ObjectID RuntimeRemoteObjectID `json:"objectId"`
+	// Expression to use as a breakpoint condition. When specified, debugger will stop on the breakpoint if this expression evaluates to true.
+	Condition string `json:"condition,omitempty"`
+}
+
+// DebuggerSetBreakpointOnFunctionCallReturns is the result of Debugger.setBreakpointOnFunctionCall.
+type DebuggerSetBreakpointOnFunctionCallReturns struct {
+	// Id of the created breakpoint for further reference.
+	BreakpointID DebuggerBreakpointID `json:"breakpointId"`
+}
+
+// DebuggerSetBreakpointsActiveParams are the parameters of Debugger.setBreakpointsActive. Activates / deactivates all breakpoints on the page.
+type DebuggerSetBreakpointsActiveParams struct {
+	// New value for breakpoints active state.
+	Active bool `json:"active"`
+}
+
+// DebuggerSetPauseOnExceptionsParams are the parameters of Debugger.setPauseOnExceptions. Defines pause on exceptions state. Can be set to stop on all exceptions, uncaught exceptions, or caught exceptions, no exceptions. Initial pause on exceptions state is `none`.
+type DebuggerSetPauseOnExceptionsParams struct {
+	// Pause on exceptions mode.
+	State string `json:"state"`
+}
+
+// DebuggerSetReturnValueParams are the parameters of Debugger.setReturnValue. Changes return value in top frame. Available only at return break position.
+type DebuggerSetReturnValueParams struct {
+	// New return value.
+	NewValue RuntimeCallArgument `json:"newValue"`
+}
+
+// DebuggerSetScriptSourceParams are the parameters of Debugger.setScriptSource. Edits JavaScript source live. In general, functions that are currently on the stack can not be edited with a single exception: If the edited function is the top-most stack frame and that is the only activation of that function on the stack. In this case the live edit will be successful and a `Debugger.restartFrame` for the top-most function is automatically triggered.
+type DebuggerSetScriptSourceParams struct {
+	// Id of the script to edit.
+	ScriptID RuntimeScriptID `json:"scriptId"`
+	// New content of the script.
+	ScriptSource string `json:"scriptSource"`
+	// If true the change will not actually be applied. Dry run may be used to get result description without actually modifying the code.
+	DryRun bool `json:"dryRun,omitempty"`
+	// If true, then `scriptSource` is allowed to change the function on top of the stack as long as the top-most stack frame is the only activation of that function.
+	AllowTopFrameEditing bool `json:"allowTopFrameEditing,omitempty"`
+}
+
+// DebuggerSetScriptSourceReturns is the result of Debugger.setScriptSource.
+type DebuggerSetScriptSourceReturns struct {
+	// New stack trace in case editing has happened while VM was stopped.
+	CallFrames []DebuggerCallFrame `json:"callFrames,omitempty"`
+	// Whether current call stack was modified after applying the changes.
+	StackChanged bool `json:"stackChanged,omitempty"`
+	// Async stack trace, if any.
+	AsyncStackTrace *RuntimeStackTrace `json:"asyncStackTrace,omitempty"`
+	// Async stack trace, if any.
+	AsyncStackTraceID *RuntimeStackTraceID `json:"asyncStackTraceId,omitempty"`
+	// Whether the operation was successful or not. Only `Ok` denotes a successful live edit while the other enum variants denote why the live edit failed.
+	Status string `json:"status"`
+	// Exception details if any. Only present when `status` is `CompileError`.
+	ExceptionDetails *RuntimeExceptionDetails `json:"exceptionDetails,omitempty"`
+}
+
+// DebuggerSetSkipAllPausesParams are the parameters of Debugger.setSkipAllPauses. Makes page not interrupt on any pauses (breakpoint, exception, dom exception etc).
+type DebuggerSetSkipAllPausesParams struct {
+	// New value for skip pauses state.
+	Skip bool `json:"skip"`
+}
+
+// DebuggerSetVariableValueParams are the parameters of Debugger.setVariableValue. Changes value of variable in a callframe. Object-based scopes are not supported and must be mutated manually.
+type DebuggerSetVariableValueParams struct {
+	// 0-based number of scope as was listed in scope chain. Only 'local', 'closure' and 'catch' scope types are allowed. Other scopes could be manipulated manually.
+	ScopeNumber int `json:"scopeNumber"`
+	// Variable name.
+	VariableName string `json:"variableName"`
+	// New variable value.
+	NewValue RuntimeCallArgument `json:"newValue"`
+	// Id of callframe that holds variable.
+	CallFrameID DebuggerCallFrameID `json:"callFrameId"`
+}
+
+// DebuggerStepIntoParams are the parameters of Debugger.stepInto. Steps into the function call.
+type DebuggerStepIntoParams struct {
+	// Debugger will pause on the execution of the first async task which was scheduled before next pause.
+	BreakOnAsyncCall bool `json:"breakOnAsyncCall,omitempty"`
+	// The skipList specifies location ranges that should be skipped on step into.
+	SkipList []DebuggerLocationRange `json:"skipList,omitempty"`
+}
+
+// DebuggerStepOverParams are the parameters of Debugger.stepOver. Steps over the statement.
+type DebuggerStepOverParams struct {
+	// The skipList specifies location ranges that should be skipped on step over.
+	SkipList []DebuggerLocationRange `json:"skipList,omitempty"`
+}
+
+// DebuggerBreakpointResolvedEvent is the Debugger.breakpointResolved event. Fired when breakpoint is resolved to an actual script and location. Deprecated in favor of `resolvedBreakpoints` in the `scriptParsed` event.
+type DebuggerBreakpointResolvedEvent struct {
+	// Breakpoint unique identifier.
+	BreakpointID DebuggerBreakpointID `json:"breakpointId"`
+	// Actual breakpoint location.
+	Location DebuggerLocation `json:"location"`
+}
+
+// DebuggerPausedEvent is the Debugger.paused event. Fired when the virtual machine stopped on breakpoint or exception or any other stop criteria.
+type DebuggerPausedEvent struct {
+	// Call stack the virtual machine stopped on.
+	CallFrames []DebuggerCallFrame `json:"callFrames"`
+	// Pause reason.
+	Reason string `json:"reason"`
+	// Object containing break-specific auxiliary properties.
+	Data json.RawMessage `json:"data,omitempty"`
+	// Hit breakpoints IDs
+	HitBreakpoints []string `json:"hitBreakpoints,omitempty"`
+	// Async stack trace, if any.
+	AsyncStackTrace *RuntimeStackTrace `json:"asyncStackTrace,omitempty"`
+	// Async stack trace, if any.
+	AsyncStackTraceID *RuntimeStackTraceID `json:"asyncStackTraceId,omitempty"`
+	// Never present, will be removed.
+	AsyncCallStackTraceID *RuntimeStackTraceID `json:"asyncCallStackTraceId,omitempty"`
+}
+
+// DebuggerResumedEvent is the Debugger.resumed event. Fired when the virtual machine resumed execution.
+type DebuggerResumedEvent struct {
+}
+
+// DebuggerScriptFailedToParseEvent is the Debugger.scriptFailedToParse event. Fired when virtual machine fails to parse the script.
+type DebuggerScriptFailedToParseEvent struct {
+	// Identifier of the script parsed.
+	ScriptID RuntimeScriptID `json:"scriptId"`
+	// URL or name of the script parsed (if any).
+	URL string `json:"url"`
+	// Line offset of the script within the resource with given URL (for script tags).
+	StartLine int `json:"startLine"`
+	// Column offset of the script within the resource with given URL.
+	StartColumn int `json:"startColumn"`
+	// Last line of the script.
+	EndLine int `json:"endLine"`
+	// Length of the last line of the script.
+	EndColumn int `json:"endColumn"`
+	// Specifies script creation context.
+	ExecutionContextID RuntimeExecutionContextID `json:"executionContextId"`
+	// Content hash of the script, SHA-256.
+	Hash string `json:"hash"`
+	// For Wasm modules, the content of the `build_id` custom section. For JavaScript the `debugId` magic comment.
+	BuildID string `json:"buildId"`
+	// Embedder-specific auxiliary data likely matching {isDefault: boolean, type: 'default'|'isolated'|'worker', frameId: string}
+	ExecutionContextAuxData json.RawMessage `json:"executionContextAuxData,omitempty"`
+	// URL of source map associated with script (if any).
+	SourceMapURL string `json:"sourceMapURL,omitempty"`
+	// True, if this script has sourceURL.
+	HasSourceURL bool `json:"hasSourceURL,omitempty"`
+	// True, if this script is ES6 module.
+	IsModule bool `json:"isModule,omitempty"`
+	// This script length.
+	Length int `json:"length,omitempty"`
+	// JavaScript top stack frame of where the script parsed event was triggered if available.
+	StackTrace *RuntimeStackTrace `json:"stackTrace,omitempty"`
+	// If the scriptLanguage is WebAssembly, the code section offset in the module.
+	CodeOffset int `json:"codeOffset,omitempty"`
+	// The language of the script.
+	ScriptLanguage DebuggerScriptLanguage `json:"scriptLanguage,omitempty"`
+	// The name the embedder supplied for this script.
+	EmbedderName string `json:"embedderName,omitempty"`
+}
+
+// DebuggerScriptParsedEvent is the Debugger.scriptParsed event. Fired when virtual machine parses script. This event is also fired for all known and uncollected scripts upon enabling debugger.
+type DebuggerScriptParsedEvent struct {
+	// Identifier of the script parsed.
+	ScriptID RuntimeScriptID `json:"scriptId"`
+	// URL or name of the script parsed (if any).
+	URL string `json:"url"`
+	// Line offset of the script within the resource with given URL (for script tags).
+	StartLine int `json:"startLine"`
+	// Column offset of the script within the resource with given URL.
+	StartColumn int `json:"startColumn"`
+	// Last line of the script.
+	EndLine int `json:"endLine"`
+	// Length of the last line of the script.
+	EndColumn int `json:"endColumn"`
+	// Specifies script creation context.
+	ExecutionContextID RuntimeExecutionContextID `json:"executionContextId"`
+	// Content hash of the script, SHA-256.
+	Hash string `json:"hash"`
+	// For Wasm modules, the content of the `build_id` custom section. For JavaScript the `debugId` magic comment.
+	BuildID string `json:"buildId"`
+	// Embedder-specific auxiliary data likely matching {isDefault: boolean, type: 'default'|'isolated'|'worker', frameId: string}
+	ExecutionContextAuxData json.RawMessage `json:"executionContextAuxData,omitempty"`
+	// True, if this script is generated as a result of the live edit operation.
+	IsLiveEdit bool `json:"isLiveEdit,omitempty"`
+	// URL of source map associated with script (if any).
+	SourceMapURL string `json:"sourceMapURL,omitempty"`
+	// True, if this script has sourceURL.
+	HasSourceURL bool `json:"hasSourceURL,omitempty"`
+	// True, if this script is ES6 module.
+	IsModule bool `json:"isModule,omitempty"`
+	// This script length.
+	Length int `json:"length,omitempty"`
+	// JavaScript top stack frame of where the script parsed event was triggered if available.
+	StackTrace *RuntimeStackTrace `json:"stackTrace,omitempty"`
+	// If the scriptLanguage is WebAssembly, the code section offset in the module.
+	CodeOffset int `json:"codeOffset,omitempty"`
+	// The language of the script.
+	ScriptLanguage DebuggerScriptLanguage `json:"scriptLanguage,omitempty"`
+	// If the scriptLanguage is WebAssembly, the source of debug symbols for the module.
+	DebugSymbols []DebuggerDebugSymbols `json:"debugSymbols,omitempty"`
+	// The name the embedder supplied for this script.
+	EmbedderName string `json:"embedderName,omitempty"`
+	// The list of set breakpoints in this script if calls to `setBreakpointByUrl` matches this script's URL or hash. Clients that use this list can ignore the `breakpointResolved` event. They are equivalent.
+	ResolvedBreakpoints []DebuggerResolvedBreakpoint `json:"resolvedBreakpoints,omitempty"`
+}
@@ -0,0 +1,18 @@
+// Package protocol is a typed model of the Chrome DevTools Protocol
+// domains radar uses, generated by cmd/cdpgen from the protocol schema
+// vendored in schema/.
+//
+// Names are prefixed with their domain: RuntimeRemoteObject is
+// Runtime.RemoteObject, RuntimeGetPropertiesParams and
+// RuntimeGetPropertiesReturns are the parameters and result of
+// Runtime.getProperties, and RuntimeConsoleAPICalledEvent holds the params
+// of Runtime.consoleAPICalled.
+//
+// To follow a newer Chrome, replace schema/browser_protocol.json and
+// schema/js_protocol.json with the ones from
+// https://github.com/ChromeDevTools/devtools-protocol/tree/master/json and
+// run go generate. A domain radar starts using has to be added to the
+// -domains list below.
+package protocol
+
+//go:generate go run ../../cmd/cdpgen -out . -domains Audits,Browser,Console,Debugger,Inspector,Log,Network,Page,Runtime,Target schema/js_protocol.json schema/browser_protocol.json
@@ -0,0 +1,225 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package protocol
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// UnmarshalEvent decodes the params of an event into a pointer to its
+// event struct, such as *RuntimeConsoleAPICalledEvent for
+// "Runtime.consoleAPICalled".
+func UnmarshalEvent(method string, params json.RawMessage) (interface{}, error) {
+	var event interface{}
+	switch method {
+	case EventAuditsIssueAdded:
+		event = &AuditsIssueAddedEvent{}
+	case EventBrowserDownloadProgress:
+		event = &BrowserDownloadProgressEvent{}
+	case EventBrowserDownloadWillBegin:
+		event = &BrowserDownloadWillBeginEvent{}
+	case EventConsoleMessageAdded:
+		event = &ConsoleMessageAddedEvent{}
+	case EventDebuggerBreakpointResolved:
+		event = &DebuggerBreakpointResolvedEvent{}
+	case EventDebuggerPaused:
+		event = &DebuggerPausedEvent{}
+	case EventDebuggerResumed:
+		event = &DebuggerResumedEvent{}
+	case EventDebuggerScriptFailedToParse:
+		event = &DebuggerScriptFailedToParseEvent{}
+	case EventDebuggerScriptParsed:
+		event = &DebuggerScriptParsedEvent{}
+	case EventInspectorDetached:
+		event = &InspectorDetachedEvent{}
+	case EventInspectorTargetCrashed:
+		event = &InspectorTargetCrashedEvent{}
+	case EventInspectorTargetReloadedAfterCrash:
+		event = &InspectorTargetReloadedAfterCrashEvent{}
+	case EventLogEntryAdded:
+		event = &LogEntryAddedEvent{}
+	case EventNetworkDataReceived:
+		event = &NetworkDataReceivedEvent{}
+	case EventNetworkDirectTCPSocketAborted:
+		event = &NetworkDirectTCPSocketAbortedEvent{}
+	case EventNetworkDirectTCPSocketChunkReceived:
+		event = &NetworkDirectTCPSocketChunkReceivedEvent{}
+	case EventNetworkDirectTCPSocketChunkSent:
+		event = &NetworkDirectTCPSocketChunkSentEvent{}
+	case EventNetworkDirectTCPSocketClosed:
+		event = &NetworkDirectTCPSocketClosedEvent{}
+	case EventNetworkDirectTCPSocketCreated:
+		event = &NetworkDirectTCPSocketCreatedEvent{}
+	case EventNetworkDirectTCPSocketOpened:
+		event = &NetworkDirectTCPSocketOpenedEvent{}
+	case EventNetworkDirectUDPSocketAborted:
+		event = &NetworkDirectUDPSocketAbortedEvent{}
+	case EventNetworkDirectUDPSocketChunkReceived:
+		event = &NetworkDirectUDPSocketChunkReceivedEvent{}
+	case EventNetworkDirectUDPSocketChunkSent:
+		event = &NetworkDirectUDPSocketChunkSentEvent{}
+	case EventNetworkDirectUDPSocketClosed:
+		event = &NetworkDirectUDPSocketClosedEvent{}
+	case EventNetworkDirectUDPSocketCreated:
+		event = &NetworkDirectUDPSocketCreatedEvent{}
+	case EventNetworkDirectUDPSocketOpened:
+		event = &NetworkDirectUDPSocketOpenedEvent{}
+	case EventNetworkEventSourceMessageReceived:
+		event = &NetworkEventSourceMessageReceivedEvent{}
+	case EventNetworkLoadingFailed:
+		event = &NetworkLoadingFailedEvent{}
+	case EventNetworkLoadingFinished:
+		event = &NetworkLoadingFinishedEvent{}
+	case EventNetworkPolicyUpdated:
+		event = &NetworkPolicyUpdatedEvent{}
+	case EventNetworkReportingAPIEndpointsChangedForOrigin:
+		event = &NetworkReportingAPIEndpointsChangedForOriginEvent{}
+	case EventNetworkReportingAPIReportAdded:
+		event = &NetworkReportingAPIReportAddedEvent{}
+	case EventNetworkReportingAPIReportUpdated:
+		event = &NetworkReportingAPIReportUpdatedEvent{}
+	case EventNetworkRequestIntercepted:
+		event = &NetworkRequestInterceptedEvent{}
+	case EventNetworkRequestServedFromCache:
+		event = &NetworkRequestServedFromCacheEvent{}
+	case EventNetworkRequestWillBeSent:
+		event = &NetworkRequestWillBeSentEvent{}
+	case EventNetworkRequestWillBeSentExtraInfo:
+		event = &NetworkRequestWillBeSentExtraInfoEvent{}
+	case EventNetworkResourceChangedPriority:
+		event = &NetworkResourceChangedPriorityEvent{}
+	case EventNetworkResponseReceived:
+		event = &NetworkResponseReceivedEvent{}
+	case EventNetworkResponseReceivedEarlyHints:
+		event = &NetworkResponseReceivedEarlyHintsEvent{}
+	case EventNetworkResponseReceivedExtraInfo:
+		event = &NetworkResponseReceivedExtraInfoEvent{}
+	case EventNetworkSignedExchangeReceived:
+		event = &NetworkSignedExchangeReceivedEvent{}
+	case EventNetworkSubresourceWebBundleInnerResponseError:
+		event = &NetworkSubresourceWebBundleInnerResponseErrorEvent{}
+	case EventNetworkSubresourceWebBundleInnerResponseParsed:
+		event = &NetworkSubresourceWebBundleInnerResponseParsedEvent{}
+	case EventNetworkSubresourceWebBundleMetadataError:
+		event = &NetworkSubresourceWebBundleMetadataErrorEvent{}
+	case EventNetworkSubresourceWebBundleMetadataReceived:
+		event = &NetworkSubresourceWebBundleMetadataReceivedEvent{}
+	case EventNetworkTrustTokenOperationDone:
+		event = &NetworkTrustTokenOperationDoneEvent{}
+	case EventNetworkWebSocketClosed:
+		event = &NetworkWebSocketClosedEvent{}
+	case EventNetworkWebSocketCreated:
+		event = &NetworkWebSocketCreatedEvent{}
+	case EventNetworkWebSocketFrameError:
+		event = &NetworkWebSocketFrameErrorEvent{}
+	case EventNetworkWebSocketFrameReceived:
+		event = &NetworkWebSocketFrameReceivedEvent{}
+	case EventNetworkWebSocketFrameSent:
+		event = &NetworkWebSocketFrameSentEvent{}
+	case EventNetworkWebSocketHandshakeResponseReceived:
+		event = &NetworkWebSocketHandshakeResponseReceivedEvent{}
+	case EventNetworkWebSocketWillSendHandshakeRequest:
+		event = &NetworkWebSocketWillSendHandshakeRequestEvent{}
+	case EventNetworkWebTransportClosed:
+		event = &NetworkWebTransportClosedEvent{}
+	case EventNetworkWebTransportConnectionEstablished:
+		event = &NetworkWebTransportConnectionEstablishedEvent{}
+	case EventNetworkWebTransportCreated:
+		event = &NetworkWebTransportCreatedEvent{}
+	case EventPageBackForwardCacheNotUsed:
+		event = &PageBackForwardCacheNotUsedEvent{}
+	case EventPageCompilationCacheProduced:
+		event = &PageCompilationCacheProducedEvent{}
+	case EventPageDOMContentEventFired:
+		event = &PageDOMContentEventFiredEvent{}
+	case EventPageDocumentOpened:
+		event = &PageDocumentOpenedEvent{}
+	case EventPageDownloadProgress:
+		event = &PageDownloadProgressEvent{}
+	case EventPageDownloadWillBegin:
+		event = &PageDownloadWillBeginEvent{}
+	case EventPageFileChooserOpened:
+		event = &PageFileChooserOpenedEvent{}
+	case EventPageFrameAttached:
+		event = &PageFrameAttachedEvent{}
+	case EventPageFrameClearedScheduledNavigation:
+		event = &PageFrameClearedScheduledNavigationEvent{}
+	case EventPageFrameDetached:
+		event = &PageFrameDetachedEvent{}
+	case EventPageFrameNavigated:
+		event = &PageFrameNavigatedEvent{}
+	case EventPageFrameRequestedNavigation:
+		event = &PageFrameRequestedNavigationEvent{}
+	case EventPageFrameResized:
+		event = &PageFrameResizedEvent{}
+	case EventPageFrameScheduledNavigation:
+		event = &PageFrameScheduledNavigationEvent{}
+	case EventPageFrameStartedLoading:
+		event = &PageFrameStartedLoadingEvent{}
+	case EventPageFrameStartedNavigating:
+		event = &PageFrameStartedNavigatingEvent{}
+	case EventPageFrameStoppedLoading:
+		event = &PageFrameStoppedLoadingEvent{}
+	case EventPageFrameSubtreeWillBeDetached:
+		event = &PageFrameSubtreeWillBeDetachedEvent{}
+	case EventPageInterstitialHidden:
+		event = &PageInterstitialHiddenEvent{}
+	case EventPageInterstitialShown:
+		event = &PageInterstitialShownEvent{}
+	case EventPageJavascriptDialogClosed:
+		event = &PageJavascriptDialogClosedEvent{}
+	case EventPageJavascriptDialogOpening:
+		event = &PageJavascriptDialogOpeningEvent{}
+	case EventPageLifecycleEvent:
+		event = &PageLifecycleEventEvent{}
+	case EventPageLoadEventFired:
+		event = &PageLoadEventFiredEvent{}
+	case EventPageNavigatedWithinDocument:
+		event = &PageNavigatedWithinDocumentEvent{}
+	case EventPageScreencastFrame:
+		event = &PageScreencastFrameEvent{}
+	case EventPageScreencastVisibilityChanged:
+		event = &PageScreencastVisibilityChangedEvent{}
+	case EventPageWindowOpen:
+		event = &PageWindowOpenEvent{}
+	case EventRuntimeBindingCalled:
+		event = &RuntimeBindingCalledEvent{}
+	case EventRuntimeConsoleAPICalled:
+		event = &RuntimeConsoleAPICalledEvent{}
+	case EventRuntimeExceptionRevoked:
+		event = &RuntimeExceptionRevokedEvent{}
+	case EventRuntimeExceptionThrown:
+		event = &RuntimeExceptionThrownEvent{}
+	case EventRuntimeExecutionContextCreated:
+		event = &RuntimeExecutionContextCreatedEvent{}
+	case EventRuntimeExecutionContextDestroyed:
+		event = &RuntimeExecutionContextDestroyedEvent{}
+	case EventRuntimeExecutionContextsCleared:
+		event = &RuntimeExecutionContextsClearedEvent{}
+	case EventRuntimeInspectRequested:
+		event = &RuntimeInspectRequestedEvent{}
+	case EventTargetAttachedToTarget:
+		event = &TargetAttachedToTargetEvent{}
+	case EventTargetDetachedFromTarget:
+		event = &TargetDetachedFromTargetEvent{}
+	case EventTargetReceivedMessageFromTarget:
+		event = &TargetReceivedMessageFromTargetEvent{}
+	case EventTargetTargetCrashed:
+		event = &TargetTargetCrashedEvent{}
+	case EventTargetTargetCreated:
+		event = &TargetTargetCreatedEvent{}
+	case EventTargetTargetDestroyed:
+		event = &TargetTargetDestroyedEvent{}
+	case EventTargetTargetInfoChanged:
+		event = &TargetTargetInfoChangedEvent{}
+	default:
+		return nil, fmt.Errorf("unknown event %s", method)
+	}
+	if len(params) > 0 {
+		if err := json.Unmarshal(params, event); err != nil {
+			return nil, fmt.Errorf("decoding %s: %v", method, err)
+		}
+	}
+	return event, nil
+}
@@ -0,0 +1,25 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package protocol
+
+const (
+	CommandInspectorDisable                = "Inspector.disable"
+	CommandInspectorEnable                 = "Inspector.enable"
+	EventInspectorDetached                 = "Inspector.detached"
+	EventInspectorTargetCrashed            = "Inspector.targetCrashed"
+	EventInspectorTargetReloadedAfterCrash = "Inspector.targetReloadedAfterCrash"
+)
+
+// InspectorDetachedEvent is the Inspector.detached event. Fired when remote debugging connection is about to be terminated. Contains detach reason.
+type InspectorDetachedEvent struct {
+	// The reason why connection has been terminated.
+	Reason string `json:"reason"`
+}
+
+// InspectorTargetCrashedEvent is the Inspector.targetCrashed event. Fired when debugging target has crashed
+type InspectorTargetCrashedEvent struct {
+}
+
+// InspectorTargetReloadedAfterCrashEvent is the Inspector.targetReloadedAfterCrash event. Fired when debugging target has reloaded after crash
+type InspectorTargetReloadedAfterCrashEvent struct {
+}
@@ -0,0 +1,59 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package protocol
+
+// Log domain: Provides access to log entries.
+
+const (
+	CommandLogClear                 = "Log.clear"
+	CommandLogDisable               = "Log.disable"
+	CommandLogEnable                = "Log.enable"
+	CommandLogStartViolationsReport = "Log.startViolationsReport"
+	CommandLogStopViolationsReport  = "Log.stopViolationsReport"
+	EventLogEntryAdded              = "Log.entryAdded"
+)
+
+// LogLogEntry: Log entry.
+type LogLogEntry struct {
+	// Log entry source.
+	Source string `json:"source"`
+	// Log entry severity.
+	Level string `json:"level"`
+	// Logged text.
+	Text     string `json:"text"`
+	Category string `json:"category,omitempty"`
+	// Timestamp when this entry was added.
+	Timestamp RuntimeTimestamp `json:"timestamp"`
+	// URL of the resource if known.
+	URL string `json:"url,omitempty"`
+	// Line number in the resource.
+	LineNumber int `json:"lineNumber,omitempty"`
+	// JavaScript stack trace.
+	StackTrace *RuntimeStackTrace `json:"stackTrace,omitempty"`
+	// Identifier of the network request associated with this entry.
+	NetworkRequestID NetworkRequestID `json:"networkRequestId,omitempty"`
+	// Identifier of the worker associated with this entry.
+	WorkerID string `json:"workerId,omitempty"`
+	// Call arguments.
+	Args []RuntimeRemoteObject `json:"args,omitempty"`
+}
+
+// LogViolationSetting: Violation configuration setting.
+type LogViolationSetting struct {
+	// Violation type.
+	Name string `json:"name"`
+	// Time threshold to trigger upon.
+	Threshold float64 `json:"threshold"`
+}
+
+// LogStartViolationsReportParams are the parameters of Log.startViolationsReport. start violation reporting.
+type LogStartViolationsReportParams struct {
+	// Configuration for violations.
+	Config []LogViolationSetting `json:"config"`
+}
+
+// LogEntryAddedEvent is the Log.entryAdded event. Issued when new message was logged.
+type LogEntryAddedEvent struct {
+	// The entry.
+	Entry LogLogEntry `json:"entry"`
+}
@@ -0,0 +1,1582 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package protocol
+
+import (
+	"encoding/json"
+)
+
+// Network domain: Network domain allows tracking network activities of the page. It exposes information about http, file, data and other requests and responses, their headers, bodies, timing, etc.
+
+const (
+	CommandNetworkSetAcceptedEncodings                    = "Network.setAcceptedEncodings"
+	CommandNetworkClearAcceptedEncodingsOverride          = "Network.clearAcceptedEncodingsOverride"
+	CommandNetworkCanClearBrowserCache                    = "Network.canClearBrowserCache"
+	CommandNetworkCanClearBrowserCookies                  = "Network.canClearBrowserCookies"
+	CommandNetworkCanEmulateNetworkConditions             = "Network.canEmulateNetworkConditions"
+	CommandNetworkClearBrowserCache                       = "Network.clearBrowserCache"
+	CommandNetworkClearBrowserCookies                     = "Network.clearBrowserCookies"
+	CommandNetworkContinueInterceptedRequest              = "Network.continueInterceptedRequest"
+	CommandNetworkDeleteCookies                           = "Network.deleteCookies"
+	CommandNetworkDisable                                 = "Network.disable"
+	CommandNetworkEmulateNetworkConditions                = "Network.emulateNetworkConditions"
+	CommandNetworkEnable                                  = "Network.enable"
+	CommandNetworkGetAllCookies                           = "Network.getAllCookies"
+	CommandNetworkGetCertificate                          = "Network.getCertificate"
+	CommandNetworkGetCookies                              = "Network.getCookies"
+	CommandNetworkGetResponseBody                         = "Network.getResponseBody"
+	CommandNetworkGetRequestPostData                      = "Network.getRequestPostData"
+	CommandNetworkGetResponseBodyForInterception          = "Network.getResponseBodyForInterception"
+	CommandNetworkTakeResponseBodyForInterceptionAsStream = "Network.takeResponseBodyForInterceptionAsStream"
+	CommandNetworkReplayXHR                               = "Network.replayXHR"
+	CommandNetworkSearchInResponseBody                    = "Network.searchInResponseBody"
+	CommandNetworkSetBlockedURLs                          = "Network.setBlockedURLs"
+	CommandNetworkSetBypassServiceWorker                  = "Network.setBypassServiceWorker"
+	CommandNetworkSetCacheDisabled                        = "Network.setCacheDisabled"
+	CommandNetworkSetCookie                               = "Network.setCookie"
+	CommandNetworkSetCookies                              = "Network.setCookies"
+	CommandNetworkSetExtraHTTPHeaders                     = "Network.setExtraHTTPHeaders"
+	CommandNetworkSetAttachDebugStack                     = "Network.setAttachDebugStack"
+	CommandNetworkSetRequestInterception                  = "Network.setRequestInterception"
+	CommandNetworkSetUserAgentOverride                    = "Network.setUserAgentOverride"
+	CommandNetworkStreamResourceContent                   = "Network.streamResourceContent"
+	CommandNetworkGetSecurityIsolationStatus              = "Network.getSecurityIsolationStatus"
+	CommandNetworkEnableReportingAPI                      = "Network.enableReportingApi"
+	CommandNetworkLoadNetworkResource                     = "Network.loadNetworkResource"
+	CommandNetworkSetCookieControls                       = "Network.setCookieControls"
+	EventNetworkDataReceived                              = "Network.dataReceived"
+	EventNetworkEventSourceMessageReceived                = "Network.eventSourceMessageReceived"
+	EventNetworkLoadingFailed                             = "Network.loadingFailed"
+	EventNetworkLoadingFinished                           = "Network.loadingFinished"
+	EventNetworkRequestIntercepted                        = "Network.requestIntercepted"
+	EventNetworkRequestServedFromCache                    = "Network.requestServedFromCache"
+	EventNetworkRequestWillBeSent                         = "Network.requestWillBeSent"
+	EventNetworkResourceChangedPriority                   = "Network.resourceChangedPriority"
+	EventNetworkSignedExchangeReceived                    = "Network.signedExchangeReceived"
+	EventNetworkResponseReceived                          = "Network.responseReceived"
+	EventNetworkWebSocketClosed                           = "Network.webSocketClosed"
+	EventNetworkWebSocketCreated                          = "Network.webSocketCreated"
+	EventNetworkWebSocketFrameError                       = "Network.webSocketFrameError"
+	EventNetworkWebSocketFrameReceived                    = "Network.webSocketFrameReceived"
+	EventNetworkWebSocketFrameSent                        = "Network.webSocketFrameSent"
+	EventNetworkWebSocketHandshakeResponseReceived        = "Network.webSocketHandshakeResponseReceived"
+	EventNetworkWebSocketWillSendHandshakeRequest         = "Network.webSocketWillSendHandshakeRequest"
+	EventNetworkWebTransportCreated                       = "Network.webTransportCreated"
+	EventNetworkWebTransportConnectionEstablished         = "Network.webTransportConnectionEstablished"
+	EventNetworkWebTransportClosed                        = "Network.webTransportClosed"
+	EventNetworkDirectTCPSocketCreated                    = "Network.directTCPSocketCreated"
+	EventNetworkDirectTCPSocketOpened                     = "Network.directTCPSocketOpened"
+	EventNetworkDirectTCPSocketAborted                    = "Network.directTCPSocketAborted"
+	EventNetworkDirectTCPSocketClosed                     = "Network.directTCPSocketClosed"
+	EventNetworkDirectTCPSocketChunkSent                  = "Network.directTCPSocketChunkSent"
+	EventNetworkDirectTCPSocketChunkReceived              = "Network.directTCPSocketChunkReceived"
+	EventNetworkDirectUDPSocketCreated                    = "Network.directUDPSocketCreated"
+	EventNetworkDirectUDPSocketOpened                     = "Network.directUDPSocketOpened"
+	EventNetworkDirectUDPSocketAborted                    = "Network.directUDPSocketAborted"
+	EventNetworkDirectUDPSocketClosed                     = "Network.directUDPSocketClosed"
+	EventNetworkDirectUDPSocketChunkSent                  = "Network.directUDPSocketChunkSent"
+	EventNetworkDirectUDPSocketChunkReceived              = "Network.directUDPSocketChunkReceived"
+	EventNetworkRequestWillBeSentExtraInfo                = "Network.requestWillBeSentExtraInfo"
+	EventNetworkResponseReceivedExtraInfo                 = "Network.responseReceivedExtraInfo"
+	EventNetworkResponseReceivedEarlyHints                = "Network.responseReceivedEarlyHints"
+	EventNetworkTrustTokenOperationDone                   = "Network.trustTokenOperationDone"
+	EventNetworkPolicyUpdated                             = "Network.policyUpdated"
+	EventNetworkSubresourceWebBundleMetadataReceived      = "Network.subresourceWebBundleMetadataReceived"
+	EventNetworkSubresourceWebBundleMetadataError         = "Network.subresourceWebBundleMetadataError"
+	EventNetworkSubresourceWebBundleInnerResponseParsed   = "Network.subresourceWebBundleInnerResponseParsed"
+	EventNetworkSubresourceWebBundleInnerResponseError    = "Network.subresourceWebBundleInnerResponseError"
+	EventNetworkReportingAPIReportAdded                   = "Network.reportingApiReportAdded"
+	EventNetworkReportingAPIReportUpdated                 = "Network.reportingApiReportUpdated"
+	EventNetworkReportingAPIEndpointsChangedForOrigin     = "Network.reportingApiEndpointsChangedForOrigin"
+)
+
+// NetworkResourceType: Resource type as it was perceived by the rendering engine.
+type NetworkResourceType = string
+
+// NetworkLoaderID: Unique loader identifier.
+type NetworkLoaderID = string
+
+// NetworkRequestID: Unique network request identifier. Note that this does not identify individual HTTP requests that are part of a network request.
+type NetworkRequestID = string
+
+// NetworkInterceptionID: Unique intercepted request identifier.
+type NetworkInterceptionID = string
+
+// NetworkErrorReason: Network level fetch failure reason.
+type NetworkErrorReason = string
+
+// NetworkTimeSinceEpoch: UTC time in seconds, counted from January 1, 1970.
+type NetworkTimeSinceEpoch = float64
+
+// NetworkMonotonicTime: Monotonically increasing time in seconds since an arbitrary point in the past.
+type NetworkMonotonicTime = float64
+
+// NetworkHeaders: Request / response headers as keys / values of JSON object.
+type NetworkHeaders = json.RawMessage
+
+// NetworkConnectionType: The underlying connection technology that the browser is supposedly using.
+type NetworkConnectionType = string
+
+// NetworkCookieSameSite: Represents the cookie's 'SameSite' status: https://tools.ietf.org/html/draft-west-first-party-cookies
+type NetworkCookieSameSite = string
+
+// NetworkCookiePriority: Represents the cookie's 'Priority' status: https://tools.ietf.org/html/draft-west-cookie-priority-00
+type NetworkCookiePriority = string
+
+// NetworkCookieSourceScheme: Represents the source scheme of the origin that originally set the cookie. A value of "Unset" allows protocol clients to emulate legacy cookie scope for the scheme. This is a temporary ability and it will be removed in the future.
+type NetworkCookieSourceScheme = string
+
+// NetworkResourceTiming: Timing information for the request.
+type NetworkResourceTiming struct {
+	// Timing's requestTime is a baseline in seconds, while the other numbers are ticks in milliseconds relatively to this requestTime.
+	RequestTime float64 `json:"requestTime"`
+	// Started resolving proxy.
+	ProxyStart float64 `json:"proxyStart"`
+	// Finished resolving proxy.
+	ProxyEnd float64 `json:"proxyEnd"`
+	// Started DNS address resolve.
+	DnsStart float64 `json:"dnsStart"`
+	// Finished DNS address resolve.
+	DnsEnd float64 `json:"dnsEnd"`
+	// Started connecting to the remote host.
+	ConnectStart float64 `json:"connectStart"`
+	// Connected to the remote host.
+	ConnectEnd float64 `json:"connectEnd"`
+	// Started SSL handshake.
+	SslStart float64 `json:"sslStart"`
+	// Finished SSL handshake.
+	SslEnd float64 `json:"sslEnd"`
+	// Started running ServiceWorker.
+	WorkerStart float64 `json:"workerStart"`
+	// Finished Starting ServiceWorker.
+	WorkerReady float64 `json:"workerReady"`
+	// Started fetch event.
+	WorkerFetchStart float64 `json:"workerFetchStart"`
+	// Settled fetch event respondWith promise.
+	WorkerRespondWithSettled float64 `json:"workerRespondWithSettled"`
+	// Started ServiceWorker static routing source evaluation.
+	WorkerRouterEvaluationStart float64 `json:"workerRouterEvaluationStart,omitempty"`
+	// Started cache lookup when the source was evaluated to `cache`.
+	WorkerCacheLookupStart float64 `json:"workerCacheLookupStart,omitempty"`
+	// Started sending request.
+	SendStart float64 `json:"sendStart"`
+	// Finished sending request.
+	SendEnd float64 `json:"sendEnd"`
+	// Time the server started pushing request.
+	PushStart float64 `json:"pushStart"`
+	// Time the server finished pushing request.
+	PushEnd float64 `json:"pushEnd"`
+	// Started receiving response headers.
+	ReceiveHeadersStart float64 `json:"receiveHeadersStart"`
+	// Finished receiving response headers.
+	ReceiveHeadersEnd float64 `json:"receiveHeadersEnd"`
+}
+
+// NetworkResourcePriority: Loading priority of a resource request.
+type NetworkResourcePriority = string
+
+// NetworkPostDataEntry: Post data entry for HTTP request
+type NetworkPostDataEntry struct {
+	Bytes string `json:"bytes,omitempty"`
+}
+
+// NetworkRequest: HTTP request data.
+type NetworkRequest struct {
+	// Request URL (without fragment).
+	URL string `json:"url"`
+	// Fragment of the requested URL starting with hash, if present.
+	URLFragment string `json:"urlFragment,omitempty"`
+	// HTTP request method.
+	Method string `json:"method"`
+	// HTTP request headers.
+	Headers NetworkHeaders `json:"headers"`
+	// HTTP POST request data. Use postDataEntries instead.
+	PostData string `json:"postData,omitempty"`
+	// True when the request has POST data. Note that postData might still be omitted when this flag is true when the data is too long.
+	HasPostData bool `json:"hasPostData,omitempty"`
+	// Request body elements (post data broken into individual entries).
+	PostDataEntries []NetworkPostDataEntry `json:"postDataEntries,omitempty"`
+	// The mixed content type of the request.
+	MixedContentType json.RawMessage `json:"mixedContentType,omitempty"`
+	// Priority of the resource request at the time request is sent.
+	InitialPriority NetworkResourcePriority `json:"initialPriority"`
+	// The referrer policy of the request, as defined in https://www.w3.org/TR/referrer-policy/
+	ReferrerPolicy string `json:"referrerPolicy"`
+	// Whether is loaded via link preload.
+	IsLinkPreload bool `json:"isLinkPreload,omitempty"`
+	// Set for requests when the TrustToken API is used. Contains the parameters passed by the developer (e.g. via "fetch") as understood by the backend.
+	TrustTokenParams *NetworkTrustTokenParams `json:"trustTokenParams,omitempty"`
+	// True if this resource request is considered to be the 'same site' as the request corresponding to the main frame.
+	IsSameSite bool `json:"isSameSite,omitempty"`
+}
+
+// NetworkSignedCertificateTimestamp: Details of a signed certificate timestamp (SCT).
+type NetworkSignedCertificateTimestamp struct {
+	// Validation status.
+	Status string `json:"status"`
+	// Origin.
+	Origin string `json:"origin"`
+	// Log name / description.
+	LogDescription string `json:"logDescription"`
+	// Log ID.
+	LogID string `json:"logId"`
+	// Issuance date. Unlike TimeSinceEpoch, this contains the number of milliseconds since January 1, 1970, UTC, not the number of seconds.
+	Timestamp float64 `json:"timestamp"`
+	// Hash algorithm.
+	HashAlgorithm string `json:"hashAlgorithm"`
+	// Signature algorithm.
+	SignatureAlgorithm string `json:"signatureAlgorithm"`
+	// Signature data.
+	SignatureData string `json:"signatureData"`
+}
+
+// NetworkSecurityDetails: Security details about a request.
+type NetworkSecurityDetails struct {
+	// Protocol name (e.g. "TLS 1.2" or "QUIC").
+	Protocol string `json:"protocol"`
+	// Key Exchange used by the connection, or the empty string if not applicable.
+	KeyExchange string `json:"keyExchange"`
+	// (EC)DH group used by the connection, if applicable.
+	KeyExchangeGroup string `json:"keyExchangeGroup,omitempty"`
+	// Cipher name.
+	Cipher string `json:"cipher"`
+	// TLS MAC. Note that AEAD ciphers do not have separate MACs.
+	Mac string `json:"mac,omitempty"`
+	// Certificate ID value.
+	CertificateID json.RawMessage `json:"certificateId"`
+	// Certificate subject name.
+	SubjectName string `json:"subjectName"`
+	// Subject Alternative Name (SAN) DNS names and IP addresses.
+	SanList []string `json:"sanList"`
+	// Name of the issuing CA.
+	Issuer string `json:"issuer"`
+	// Certificate valid from date.
+	ValidFrom NetworkTimeSinceEpoch `json:"validFrom"`
+	// Certificate valid to (expiration) date
+	ValidTo NetworkTimeSinceEpoch `json:"validTo"`
+	// List of signed certificate timestamps (SCTs).
+	SignedCertificateTimestampList []NetworkSignedCertificateTimestamp `json:"signedCertificateTimestampList"`
+	// Whether the request complied with Certificate Transparency policy
+	CertificateTransparencyCompliance NetworkCertificateTransparencyCompliance `json:"certificateTransparencyCompliance"`
+	// The signature algorithm used by the server in the TLS server signature, represented as a TLS SignatureScheme code point. Omitted if not applicable or not known.
+	ServerSignatureAlgorithm int `json:"serverSignatureAlgorithm,omitempty"`
+	// Whether the connection used Encrypted ClientHello
+	EncryptedClientHello bool `json:"encryptedClientHello"`
+}
+
+// NetworkCertificateTransparencyCompliance: Whether the request complied with Certificate Transparency policy.
+type NetworkCertificateTransparencyCompliance = string
+
+// NetworkBlockedReason: The reason why request was blocked.
+type NetworkBlockedReason = string
+
+// NetworkCorsError: The reason why request was blocked.
+type NetworkCorsError = string
+
+type NetworkCorsErrorStatus struct {
+	CorsError       NetworkCorsError `json:"corsError"`
+	FailedParameter string           `json:"failedParameter"`
+}
+
+// NetworkServiceWorkerResponseSource: Source of serviceworker response.
+type NetworkServiceWorkerResponseSource = string
+
+// NetworkTrustTokenParams: Determines what type of Trust Token operation is executed and depending on the type, some additional parameters. The values are specified in third_party/blink/renderer/core/fetch/trust_token.idl.
+type NetworkTrustTokenParams struct {
+	Operation NetworkTrustTokenOperationType `json:"operation"`
+	// Only set for "token-redemption" operation and determine whether to request a fresh SRR or use a still valid cached SRR.
+	RefreshPolicy string `json:"refreshPolicy"`
+	// Origins of issuers from whom to request tokens or redemption records.
+	Issuers []string `json:"issuers,omitempty"`
+}
+
+type NetworkTrustTokenOperationType = string
+
+// NetworkAlternateProtocolUsage: The reason why Chrome uses a specific transport protocol for HTTP semantics.
+type NetworkAlternateProtocolUsage = string
+
+// NetworkServiceWorkerRouterSource: Source of service worker router.
+type NetworkServiceWorkerRouterSource = string
+
+type NetworkServiceWorkerRouterInfo struct {
+	// ID of the rule matched. If there is a matched rule, this field will be set, otherwiser no value will be set.
+	RuleIDMatched int `json:"ruleIdMatched,omitempty"`
+	// The router source of the matched rule. If there is a matched rule, this field will be set, otherwise no value will be set.
+	MatchedSourceType NetworkServiceWorkerRouterSource `json:"matchedSourceType,omitempty"`
+	// The actual router source used.
+	ActualSourceType NetworkServiceWorkerRouterSource `json:"actualSourceType,omitempty"`
+}
+
+// NetworkResponse: HTTP response data.
+type NetworkResponse struct {
+	// Response URL. This URL can be different from CachedResource.url in case of redirect.
+	URL string `json:"url"`
+	// HTTP response status code.
+	Status int `json:"status"`
+	// HTTP response status text.
+	StatusText string `json:"statusText"`
+	// HTTP response headers.
+	Headers NetworkHeaders `json:"headers"`
+	// HTTP response headers text. This has been replaced by the headers in Network.responseReceivedExtraInfo.
+	HeadersText string `json:"headersText,omitempty"`
+	// Resource mimeType as determined by the browser.
+	MimeType string `json:"mimeType"`
+	// Resource charset as determined by the browser (if applicable).
+	Charset string `json:"charset"`
+	// Refined HTTP request headers that were actually transmitted over the network.
+	RequestHeaders NetworkHeaders `json:"requestHeaders,omitempty"`
+	// HTTP request headers text. This has been replaced by the headers in Network.requestWillBeSentExtraInfo.
+	RequestHeadersText string `json:"requestHeadersText,omitempty"`
+	// Specifies whether physical connection was actually reused for this request.
+	ConnectionReused bool `json:"connectionReused"`
+	// Physical connection id that was actually used for this request.
+	ConnectionID float64 `json:"connectionId"`
+	// Remote IP address.
+	RemoteIPAddress string `json:"remoteIPAddress,omitempty"`
+	// Remote port.
+	RemotePort int `json:"remotePort,omitempty"`
+	// Specifies that the request was served from the disk cache.
+	FromDiskCache bool `json:"fromDiskCache,omitempty"`
+	// Specifies that the request was served from the ServiceWorker.
+	FromServiceWorker bool `json:"fromServiceWorker,omitempty"`
+	// Specifies that the request was served from the prefetch cache.
+	FromPrefetchCache bool `json:"fromPrefetchCache,omitempty"`
+	// Specifies that the request was served from the prefetch cache.
+	FromEarlyHints bool `json:"fromEarlyHints,omitempty"`
+	// Information about how ServiceWorker Static Router API was used. If this field is set with `matchedSourceType` field, a matching rule is found. If this field is set without `matchedSource`, no matching rule is found. Otherwise, the API is not used.
+	ServiceWorkerRouterInfo *NetworkServiceWorkerRouterInfo `json:"serviceWorkerRouterInfo,omitempty"`
+	// Total number of bytes received for this request so far.
+	EncodedDataLength float64 `json:"encodedDataLength"`
+	// Timing information for the given request.
+	Timing *NetworkResourceTiming `json:"timing,omitempty"`
+	// Response source of response from ServiceWorker.
+	ServiceWorkerResponseSource NetworkServiceWorkerResponseSource `json:"serviceWorkerResponseSource,omitempty"`
+	// The time at which the returned response was generated.
+	ResponseTime NetworkTimeSinceEpoch `json:"responseTime,omitempty"`
+	// Cache Storage Cache Name.
+	CacheStorageCacheName string `json:"cacheStorageCacheName,omitempty"`
+	// Protocol used to fetch this request.
+	Protocol string `json:"protocol,omitempty"`
+	// The reason why Chrome uses a specific transport protocol for HTTP semantics.
+	AlternateProtocolUsage NetworkAlternateProtocolUsage `json:"alternateProtocolUsage,omitempty"`
+	// Security state of the request resource.
+	SecurityState json.RawMessage `json:"securityState"`
+	// Security details for the request.
+	SecurityDetails *NetworkSecurityDetails `json:"securityDetails,omitempty"`
+	// Indicates whether the request was sent through IP Protection proxies. If set to true, the request used the IP Protection privacy feature.
+	IsIPProtectionUsed bool `json:"isIpProtectionUsed,omitempty"`
+}
+
+// NetworkWebSocketRequest: WebSocket request data.
+type NetworkWebSocketRequest struct {
+	// HTTP request headers.
+	Headers NetworkHeaders `json:"headers"`
+}
+
+// NetworkWebSocketResponse: WebSocket response data.
+type NetworkWebSocketResponse struct {
+	// HTTP response status code.
+	Status int `json:"status"`
+	// HTTP response status text.
+	StatusText string `json:"statusText"`
+	// HTTP response headers.
+	Headers NetworkHeaders `json:"headers"`
+	// HTTP response headers text.
+	HeadersText string `json:"headersText,omitempty"`
+	// HTTP request headers.
+	RequestHeaders NetworkHeaders `json:"requestHeaders,omitempty"`
+	// HTTP request headers text.
+	RequestHeadersText string `json:"requestHeadersText,omitempty"`
+}
+
+// NetworkWebSocketFrame: WebSocket message data. This represents an entire WebSocket message, not just a fragmented frame as the name suggests.
+type NetworkWebSocketFrame struct {
+	// WebSocket message opcode.
+	Opcode float64 `json:"opcode"`
+	// WebSocket message mask.
+	Mask bool `json:"mask"`
+	// WebSocket message payload data. If the opcode is 1, this is a text message and payloadData is a UTF-8 string. If the opcode isn't 1, then payloadData is a base64 encoded string representing binary data.
+	PayloadData string `json:"payloadData"`
+}
+
+// NetworkCachedResource: Information about the cached resource.
+type NetworkCachedResource struct {
+	// Resource URL. This is the url of the original network request.
+	URL string `json:"url"`
+	// Type of this resource.
+	Type NetworkResourceType `json:"type"`
+	// Cached response data.
+	Response *NetworkResponse `json:"response,omitempty"`
+	// Cached response body size.
+	BodySize float64 `json:"bodySize"`
+}
+
+// NetworkInitiator: Information about the request initiator.
+type NetworkInitiator struct {
+	// Type of this initiator.
+	Type string `json:"type"`
+	// Initiator JavaScript stack trace, set for Script only. Requires the Debugger domain to be enabled.
+	Stack *RuntimeStackTrace `json:"stack,omitempty"`
+	// Initiator URL, set for Parser type or for Script type (when script is importing module) or for SignedExchange type.
+	URL string `json:"url,omitempty"`
+	// Initiator line number, set for Parser type or for Script type (when script is importing module) (0-based).
+	LineNumber float64 `json:"lineNumber,omitempty"`
+	// Initiator column number, set for Parser type or for Script type (when script is importing module) (0-based).
+	ColumnNumber float64 `json:"columnNumber,omitempty"`
+	// Set if another request triggered this request (e.g. preflight).
+	RequestID NetworkRequestID `json:"requestId,omitempty"`
+}
+
+// NetworkCookiePartitionKey: cookiePartitionKey object The representation of the components of the key that are created by the cookiePartitionKey class contained in net/cookies/cookie_partition_key.h.
+type NetworkCookiePartitionKey struct {
+	// The site of the top-level URL the browser was visiting at the start of the request to the endpoint that set the cookie.
+	TopLevelSite string `json:"topLevelSite"`
+	// Indicates if the cookie has any ancestors that are cross-site to the topLevelSite.
+	HasCrossSiteAncestor bool `json:"hasCrossSiteAncestor"`
+}
+
+// NetworkCookie: Cookie object
+type NetworkCookie struct {
+	// Cookie name.
+	Name string `json:"name"`
+	// Cookie value.
+	Value string `json:"value"`
+	// Cookie domain.
+	Domain string `json:"domain"`
+	// Cookie path.
+	Path string `json:"path"`
+	// Cookie expiration date as the number of seconds since the UNIX epoch.
+	Expires float64 `json:"expires"`
+	// Cookie size.
+	Size int `json:"size"`
+	// True if cookie is http-only.
+	HTTPOnly bool `json:"httpOnly"`
+	// True if cookie is secure.
+	Secure bool `json:"secure"`
+	// True in case of session cookie.
+	Session bool `json:"session"`
+	// Cookie SameSite type.
+	SameSite NetworkCookieSameSite `json:"sameSite,omitempty"`
+	// Cookie Priority
+	Priority NetworkCookiePriority `json:"priority"`
+	// True if cookie is SameParty.
+	SameParty bool `json:"sameParty"`
+	// Cookie source scheme type.
+	SourceScheme NetworkCookieSourceScheme `json:"sourceScheme"`
+	// Cookie source port. Valid values are {-1, [1, 65535]}, -1 indicates an unspecified port. An unspecified port value allows protocol clients to emulate legacy cookie scope for the port. This is a temporary ability and it will be removed in the future.
+	SourcePort int `json:"sourcePort"`
+	// Cookie partition key.
+	PartitionKey *NetworkCookiePartitionKey `json:"partitionKey,omitempty"`
+	// True if cookie partition key is opaque.
+	PartitionKeyOpaque bool `json:"partitionKeyOpaque,omitempty"`
+}
+
+// NetworkSetCookieBlockedReason: Types of reasons why a cookie may not be stored from a response.
+type NetworkSetCookieBlockedReason = string
+
+// NetworkCookieBlockedReason: Types of reasons why a cookie may not be sent with a request.
+type NetworkCookieBlockedReason = string
+
+// NetworkCookieExemptionReason: Types of reasons why a cookie should have been blocked by 3PCD but is exempted for the request.
+type NetworkCookieExemptionReason = string
+
+// NetworkBlockedSetCookieWithReason: A cookie which was not stored from a response with the corresponding reason.
+type NetworkBlockedSetCookieWithReason struct {
+	// The reason(s) this cookie was blocked.
+	BlockedReasons []NetworkSetCookieBlockedReason `json:"blockedReasons"`
+	// The string representing this individual cookie as it would appear in the header. This is not the entire "cookie" or "set-cookie" header which could have multiple cookies.
+	CookieLine string `json:"cookieLine"`
+	// The cookie object which represents the cookie which was not stored. It is optional because sometimes complete cookie information is not available, such as in the case of parsing errors.
+	Cookie *NetworkCookie `json:"cookie,omitempty"`
+}
+
+// NetworkExemptedSetCookieWithReason: A cookie should have been blocked by 3PCD but is exempted and stored from a response with the corresponding reason. A cookie could only have at most one exemption reason.
+type NetworkExemptedSetCookieWithReason struct {
+	// The reason the cookie was exempted.
+	ExemptionReason NetworkCookieExemptionReason `json:"exemptionReason"`
+	// The string representing this individual cookie as it would appear in the header.
+	CookieLine string `json:"cookieLine"`
+	// The cookie object representing the cookie.
+	Cookie NetworkCookie `json:"cookie"`
+}
+
+// NetworkAssociatedCookie: A cookie associated with the request which may or may not be sent with it. Includes the cookies itself and reasons for blocking or exemption.
+type NetworkAssociatedCookie struct {
+	// The cookie object representing the cookie which was not sent.
+	Cookie NetworkCookie `json:"cookie"`
+	// The reason(s) the cookie was blocked. If empty means the cookie is included.
+	BlockedReasons []NetworkCookieBlockedReason `json:"blockedReasons"`
+	// The reason the cookie should have been blocked by 3PCD but is exempted. A cookie could only have at most one exemption reason.
+	ExemptionReason NetworkCookieExemptionReason `json:"exemptionReason,omitempty"`
+}
+
+// NetworkCookieParam: Cookie parameter object
+type NetworkCookieParam struct {
+	// Cookie name.
+	Name string `json:"name"`
+	// Cookie value.
+	Value string `json:"value"`
+	// The request-URI to associate with the setting of the cookie. This value can affect the default domain, path, source port, and source scheme values of the created cookie.
+	URL string `json:"url,omitempty"`
+	// Cookie domain.
+	Domain string `json:"domain,omitempty"`
+	// Cookie path.
+	Path string `json:"path,omitempty"`
+	// True if cookie is secure.
+	Secure bool `json:"secure,omitempty"`
+	// True if cookie is http-only.
+	HTTPOnly bool `json:"httpOnly,omitempty"`
+	// Cookie SameSite type.
+	SameSite NetworkCookieSameSite `json:"sameSite,omitempty"`
+	// Cookie expiration date, session cookie if not set
+	Expires NetworkTimeSinceEpoch `json:"expires,omitempty"`
+	// Cookie Priority.
+	Priority NetworkCookiePriority `json:"priority,omitempty"`
+	// True if cookie is SameParty.
+	SameParty bool `json:"sameParty,omitempty"`
+	// Cookie source scheme type.
+	SourceScheme NetworkCookieSourceScheme `json:"sourceScheme,omitempty"`
+	// Cookie source port. Valid values are {-1, [1, 65535]}, -1 indicates an unspecified port. An unspecified port value allows protocol clients to emulate legacy cookie scope for the port. This is a temporary ability and it will be removed in the future.
+	SourcePort int `json:"sourcePort,omitempty"`
+	// Cookie partition key. If not set, the cookie will be set as not partitioned.
+	PartitionKey *NetworkCookiePartitionKey `json:"partitionKey,omitempty"`
+}
+
+// NetworkAuthChallenge: Authorization challenge for HTTP status code 401 or 407.
+type NetworkAuthChallenge struct {
+	// Source of the authentication challenge.
+	Source string `json:"source,omitempty"`
+	// Origin of the challenger.
+	Origin string `json:"origin"`
+	// The authentication scheme used, such as basic or digest
+	Scheme string `json:"scheme"`
+	// The realm of the challenge. May be empty.
+	Realm string `json:"realm"`
+}
+
+// NetworkAuthChallengeResponse: Response to an AuthChallenge.
+type NetworkAuthChallengeResponse struct {
+	// The decision on what to do in response to the authorization challenge. Default means deferring to the default behavior of the net stack, which will likely either the Cancel authentication or display a popup dialog box.
+	Response string `json:"response"`
+	// The username to provide, possibly empty. Should only be set if response is ProvideCredentials.
+	Username string `json:"username,omitempty"`
+	// The password to provide, possibly empty. Should only be set if response is ProvideCredentials.
+	Password string `json:"password,omitempty"`
+}
+
+// NetworkInterceptionStage: Stages of the interception to begin intercepting. Request will intercept before the request is sent. Response will intercept after the response is received.
+type NetworkInterceptionStage = string
+
+// NetworkRequestPattern: Request pattern for interception.
+type NetworkRequestPattern struct {
+	// Wildcards (`'*'` -> zero or more, `'?'` -> exactly one) are allowed. Escape character is backslash. Omitting is equivalent to `"*"`.
+	URLPattern string `json:"urlPattern,omitempty"`
+	// If set, only requests for matching resource types will be intercepted.
+	ResourceType NetworkResourceType `json:"resourceType,omitempty"`
+	// Stage at which to begin intercepting requests. Default is Request.
+	InterceptionStage NetworkInterceptionStage `json:"interceptionStage,omitempty"`
+}
+
+// NetworkSignedExchangeSignature: Information about a signed exchange signature. https://wicg.github.io/webpackage/draft-yasskin-httpbis-origin-signed-exchanges-impl.html#rfc.section.3.1
+type NetworkSignedExchangeSignature struct {
+	// Signed exchange signature label.
+	Label string `json:"label"`
+	// The hex string of signed exchange signature.
+	Signature string `json:"signature"`
+	// Signed exchange signature integrity.
+	Integrity string `json:"integrity"`
+	// Signed exchange signature cert Url.
+	CertURL string `json:"certUrl,omitempty"`
+	// The hex string of signed exchange signature cert sha256.
+	CertSha256 string `json:"certSha256,omitempty"`
+	// Signed exchange signature validity Url.
+	ValidityURL string `json:"validityUrl"`
+	// Signed exchange signature date.
+	Date int `json:"date"`
+	// Signed exchange signature expires.
+	Expires int `json:"expires"`
+	// The encoded certificates.
+	Certificates []string `json:"certificates,omitempty"`
+}
+
+// NetworkSignedExchangeHeader: Information about a signed exchange header. https://wicg.github.io/webpackage/draft-yasskin-httpbis-origin-signed-exchanges-impl.html#cbor-representation
+type NetworkSignedExchangeHeader struct {
+	// Signed exchange request URL.
+	RequestURL string `json:"requestUrl"`
+	// Signed exchange response code.
+	ResponseCode int `json:"responseCode"`
+	// Signed exchange response headers.
+	ResponseHeaders NetworkHeaders `json:"responseHeaders"`
+	// Signed exchange response signature.
+	Signatures []NetworkSignedExchangeSignature `json:"signatures"`
+	// Signed exchange header integrity hash in the form of `sha256-<base64-hash-value>`.
+	HeaderIntegrity string `json:"headerIntegrity"`
+}
+
+// NetworkSignedExchangeErrorField: Field type for a signed exchange related error.
+type NetworkSignedExchangeErrorField = string
+
+// NetworkSignedExchangeError: Information about a signed exchange response.
+type NetworkSignedExchangeError struct {
+	// Error message.
+	Message string `json:"message"`
+	// The index of the signature which caused the error.
+	SignatureIndex int `json:"signatureIndex,omitempty"`
+	// The field which caused the error.
+	ErrorField NetworkSignedExchangeErrorField `json:"errorField,omitempty"`
+}
+
+// NetworkSignedExchangeInfo: Information about a signed exchange response.
+type NetworkSignedExchangeInfo struct {
+	// The outer response of signed HTTP exchange which was received from network.
+	OuterResponse NetworkResponse `json:"outerResponse"`
+	// Whether network response for the signed exchange was accompanied by extra headers.
+	HasExtraInfo bool `json:"hasExtraInfo"`
+	// Information about the signed exchange header.
+	Header *NetworkSignedExchangeHeader `json:"header,omitempty"`
+	// Security details for the signed exchange header.
+	SecurityDetails *NetworkSecurityDetails `json:"securityDetails,omitempty"`
+	// Errors occurred while handling the signed exchange.
+	Errors []NetworkSignedExchangeError `json:"errors,omitempty"`
+}
+
+// NetworkContentEncoding: List of content encodings supported by the backend.
+type NetworkContentEncoding = string
+
+type NetworkDirectSocketDnsQueryType = string
+
+type NetworkDirectTCPSocketOptions struct {
+	// TCP_NODELAY option
+	NoDelay bool `json:"noDelay"`
+	// Expected to be unsigned integer.
+	KeepAliveDelay float64 `json:"keepAliveDelay,omitempty"`
+	// Expected to be unsigned integer.
+	SendBufferSize float64 `json:"sendBufferSize,omitempty"`
+	// Expected to be unsigned integer.
+	ReceiveBufferSize float64                         `json:"receiveBufferSize,omitempty"`
+	DnsQueryType      NetworkDirectSocketDnsQueryType `json:"dnsQueryType,omitempty"`
+}
+
+type NetworkDirectUDPSocketOptions struct {
+	RemoteAddr string `json:"remoteAddr,omitempty"`
+	// Unsigned int 16.
+	RemotePort int    `json:"remotePort,omitempty"`
+	LocalAddr  string `json:"localAddr,omitempty"`
+	// Unsigned int 16.
+	LocalPort    int                             `json:"localPort,omitempty"`
+	DnsQueryType NetworkDirectSocketDnsQueryType `json:"dnsQueryType,omitempty"`
+	// Expected to be unsigned integer.
+	SendBufferSize float64 `json:"sendBufferSize,omitempty"`
+	// Expected to be unsigned integer.
+	ReceiveBufferSize float64 `json:"receiveBufferSize,omitempty"`
+}
+
+type NetworkDirectUDPMessage struct {
+	Data string `json:"data"`
+	// Null for connected mode.
+	RemoteAddr string `json:"remoteAddr,omitempty"`
+	// Null for connected mode. Expected to be unsigned integer.
+	RemotePort int `json:"remotePort,omitempty"`
+}
+
+type NetworkPrivateNetworkRequestPolicy = string
+
+type NetworkIPAddressSpace = string
+
+type NetworkConnectTiming struct {
+	// Timing's requestTime is a baseline in seconds, while the other numbers are ticks in milliseconds relatively to this requestTime. Matches ResourceTiming's requestTime for the same request (but not for redirected requests).
+	RequestTime float64 `json:"requestTime"`
+}
+
+type NetworkClientSecurityState struct {
+	InitiatorIsSecureContext    bool                               `json:"initiatorIsSecureContext"`
+	InitiatorIPAddressSpace     NetworkIPAddressSpace              `json:"initiatorIPAddressSpace"`
+	PrivateNetworkRequestPolicy NetworkPrivateNetworkRequestPolicy `json:"privateNetworkRequestPolicy"`
+}
+
+type NetworkCrossOriginOpenerPolicyValue = string
+
+type NetworkCrossOriginOpenerPolicyStatus struct {
+	Value                       NetworkCrossOriginOpenerPolicyValue `json:"value"`
+	ReportOnlyValue             NetworkCrossOriginOpenerPolicyValue `json:"reportOnlyValue"`
+	ReportingEndpoint           string                              `json:"reportingEndpoint,omitempty"`
+	ReportOnlyReportingEndpoint string                              `json:"reportOnlyReportingEndpoint,omitempty"`
+}
+
+type NetworkCrossOriginEmbedderPolicyValue = string
+
+type NetworkCrossOriginEmbedderPolicyStatus struct {
+	Value                       NetworkCrossOriginEmbedderPolicyValue `json:"value"`
+	ReportOnlyValue             NetworkCrossOriginEmbedderPolicyValue `json:"reportOnlyValue"`
+	ReportingEndpoint           string                                `json:"reportingEndpoint,omitempty"`
+	ReportOnlyReportingEndpoint string                                `json:"reportOnlyReportingEndpoint,omitempty"`
+}
+
+type NetworkContentSecurityPolicySource = string
+
+type NetworkContentSecurityPolicyStatus struct {
+	EffectiveDirectives string                             `json:"effectiveDirectives"`
+	IsEnforced          bool                               `json:"isEnforced"`
+	Source              NetworkContentSecurityPolicySource `json:"source"`
+}
+
+type NetworkSecurityIsolationStatus struct {
+	Coop *NetworkCrossOriginOpenerPolicyStatus   `json:"coop,omitempty"`
+	Coep *NetworkCrossOriginEmbedderPolicyStatus `json:"coep,omitempty"`
+	Csp  []NetworkContentSecurityPolicyStatus    `json:"csp,omitempty"`
+}
+
+// NetworkReportStatus: The status of a Reporting API report.
+type NetworkReportStatus = string
+
+type NetworkReportID = string
+
+// NetworkReportingAPIReport: An object representing a report generated by the Reporting API.
+type NetworkReportingAPIReport struct {
+	ID NetworkReportID `json:"id"`
+	// The URL of the document that triggered the report.
+	InitiatorURL string `json:"initiatorUrl"`
+	// The name of the endpoint group that should be used to deliver the report.
+	Destination string `json:"destination"`
+	// The type of the report (specifies the set of data that is contained in the report body).
+	Type string `json:"type"`
+	// When the report was generated.
+	Timestamp NetworkTimeSinceEpoch `json:"timestamp"`
+	// How many uploads deep the related request was.
+	Depth int `json:"depth"`
+	// The number of delivery attempts made so far, not including an active attempt.
+	CompletedAttempts int                 `json:"completedAttempts"`
+	Body              json.RawMessage     `json:"body"`
+	Status            NetworkReportStatus `json:"status"`
+}
+
+type NetworkReportingAPIEndpoint struct {
+	// The URL of the endpoint to which reports may be delivered.
+	URL string `json:"url"`
+	// Name of the endpoint group.
+	GroupName string `json:"groupName"`
+}
+
+// NetworkLoadNetworkResourcePageResult: An object providing the result of a network resource load.
+type NetworkLoadNetworkResourcePageResult struct {
+	Success bool `json:"success"`
+	// Optional values used for error reporting.
+	NetError       float64 `json:"netError,omitempty"`
+	NetErrorName   string  `json:"netErrorName,omitempty"`
+	HTTPStatusCode float64 `json:"httpStatusCode,omitempty"`
+	// If successful, one of the following two fields holds the result.
+	Stream json.RawMessage `json:"stream,omitempty"`
+	// Response headers.
+	Headers NetworkHeaders `json:"headers,omitempty"`
+}
+
+// NetworkLoadNetworkResourceOptions: An options object that may be extended later to better support CORS, CORB and streaming.
+type NetworkLoadNetworkResourceOptions struct {
+	DisableCache       bool `json:"disableCache"`
+	IncludeCredentials bool `json:"includeCredentials"`
+}
+
+// NetworkSetAcceptedEncodingsParams are the parameters of Network.setAcceptedEncodings. Sets a list of content encodings that will be accepted. Empty list means no encoding is accepted.
+type NetworkSetAcceptedEncodingsParams struct {
+	// List of accepted content encodings.
+	Encodings []NetworkContentEncoding `json:"encodings"`
+}
+
+// NetworkCanClearBrowserCacheReturns is the result of Network.canClearBrowserCache.
+type NetworkCanClearBrowserCacheReturns struct {
+	// True if browser cache can be cleared.
+	Result bool `json:"result"`
+}
+
+// NetworkCanClearBrowserCookiesReturns is the result of Network.canClearBrowserCookies.
+type NetworkCanClearBrowserCookiesReturns struct {
+	// True if browser cookies can be cleared.
+	Result bool `json:"result"`
+}
+
+// NetworkCanEmulateNetworkConditionsReturns is the result of Network.canEmulateNetworkConditions.
+type NetworkCanEmulateNetworkConditionsReturns struct {
+	// True if emulation of network conditions is supported.
+	Result bool `json:"result"`
+}
+
+// NetworkContinueInterceptedRequestParams are the parameters of Network.continueInterceptedRequest. Response to Network.requestIntercepted which either modifies the request to continue with any modifications, or blocks it, or completes it with the provided response bytes. If a network fetch occurs as a result which encounters a redirect an additional Network.requestIntercepted event will be sent with the same InterceptionId. Deprecated, use Fetch.continueRequest, Fetch.fulfillRequest and Fetch.failRequest instead.
+type NetworkContinueInterceptedRequestParams struct {
+	InterceptionID NetworkInterceptionID `json:"interceptionId"`
+	// If set this causes the request to fail with the given reason. Passing `Aborted` for requests marked with `isNavigationRequest` also cancels the navigation. Must not be set in response to an authChallenge.
+	ErrorReason NetworkErrorReason `json:"errorReason,omitempty"`
+	// If set the requests completes using with the provided base64 encoded raw response, including HTTP status line and headers etc... Must not be set in response to an authChallenge. (Encoded as a base64 string when passed over JSON)
+	RawResponse string `json:"rawResponse,omitempty"`
+	// If set the request url will be modified in a way that's not observable by page. Must not be set in response to an authChallenge.
+	URL string `json:"url,omitempty"`
+	// If set this allows the request method to be overridden. Must not be set in response to an authChallenge.
+	Method string `json:"method,omitempty"`
+	// If set this allows postData to be set. Must not be set in response to an authChallenge.
+	PostData string `json:"postData,omitempty"`
+	// If set this allows the request headers to be changed. Must not be set in response to an authChallenge.
+	Headers NetworkHeaders `json:"headers,omitempty"`
+	// Response to a requestIntercepted with an authChallenge. Must not be set otherwise.
+	AuthChallengeResponse *NetworkAuthChallengeResponse `json:"authChallengeResponse,omitempty"`
+}
+
+// NetworkDeleteCookiesParams are the parameters of Network.deleteCookies. Deletes browser cookies with matching name and url or domain/path/partitionKey pair.
+type NetworkDeleteCookiesParams struct {
+	// Name of the cookies to remove.
+	Name string `json:"name"`
+	// If specified, deletes all the cookies with the given name where domain and path match provided URL.
+	URL string `json:"url,omitempty"`
+	// If specified, deletes only cookies with the exact domain.
+	Domain string `json:"domain,omitempty"`
+	// If specified, deletes only cookies with the exact path.
+	Path string `json:"path,omitempty"`
+	// If specified, deletes only cookies with the the given name and partitionKey where all partition key attributes match the cookie partition key attribute.
+	PartitionKey *NetworkCookiePartitionKey `json:"partitionKey,omitempty"`
+}
+
+// NetworkEmulateNetworkConditionsParams are the parameters of Network.emulateNetworkConditions. Activates emulation of network conditions.
+type NetworkEmulateNetworkConditionsParams struct {
+	// True to emulate internet disconnection.
+	Offline bool `json:"offline"`
+	// Minimum latency from request sent to response headers received (ms).
+	Latency float64 `json:"latency"`
+	// Maximal aggregated download throughput (bytes/sec). -1 disables download throttling.
+	DownloadThroughput float64 `json:"downloadThroughput"`
+	// Maximal aggregated upload throughput (bytes/sec). -1 disables upload throttling.
+	UploadThroughput float64 `json:"uploadThroughput"`
+	// Connection type if known.
+	ConnectionType NetworkConnectionType `json:"connectionType,omitempty"`
+	// WebRTC packet loss (percent, 0-100). 0 disables packet loss emulation, 100 drops all the packets.
+	PacketLoss float64 `json:"packetLoss,omitempty"`
+	// WebRTC packet queue length (packet). 0 removes any queue length limitations.
+	PacketQueueLength int `json:"packetQueueLength,omitempty"`
+	// WebRTC packetReordering feature.
+	PacketReordering bool `json:"packetReordering,omitempty"`
+}
+
+// NetworkEnableParams are the parameters of Network.enable. Enables network tracking, network events will now be delivered to the client.
+type NetworkEnableParams struct {
+	// Buffer size in bytes to use when preserving network payloads (XHRs, etc).
+	MaxTotalBufferSize int `json:"maxTotalBufferSize,omitempty"`
+	// Per-resource buffer size in bytes to use when preserving network payloads (XHRs, etc).
+	MaxResourceBufferSize int `json:"maxResourceBufferSize,omitempty"`
+	// Longest post body size (in bytes) that would be included in requestWillBeSent notification
+	MaxPostDataSize int `json:"maxPostDataSize,omitempty"`
+	// Whether DirectSocket chunk send/receive events should be reported.
+	ReportDirectSocketTraffic bool `json:"reportDirectSocketTraffic,omitempty"`
+}
+
+// NetworkGetAllCookiesReturns is the result of Network.getAllCookies.
+type NetworkGetAllCookiesReturns struct {
+	// Array of cookie objects.
+	Cookies []NetworkCookie `json:"cookies"`
+}
+
+// NetworkGetCertificateParams are the parameters of Network.getCertificate. Returns the DER-encoded certificate.
+type NetworkGetCertificateParams struct {
+	// Origin to get certificate for.
+	Origin string `json:"origin"`
+}
+
+// NetworkGetCertificateReturns is the result of Network.getCertificate.
+type NetworkGetCertificateReturns struct {
+	TableNames []string `json:"tableNames"`
+}
+
+// NetworkGetCookiesParams are the parameters of Network.getCookies. Returns all browser cookies for the current URL. Depending on the backend support, will return detailed cookie information in the `cookies` field.
+type NetworkGetCookiesParams struct {
+	// The list of URLs for which applicable cookies will be fetched. If not specified, it's assumed to be set to the list containing the URLs of the page and all of its subframes.
+	Urls []string `json:"urls,omitempty"`
+}
+
+// NetworkGetCookiesReturns is the result of Network.getCookies.
+type NetworkGetCookiesReturns struct {
+	// Array of cookie objects.
+	Cookies []NetworkCookie `json:"cookies"`
+}
+
+// NetworkGetResponseBodyParams are the parameters of Network.getResponseBody. Returns content served for the given request.
+type NetworkGetResponseBodyParams struct {
+	// Identifier of the network request to get content for.
+	RequestID NetworkRequestID `json:"requestId"`
+}
+
+// NetworkGetResponseBodyReturns is the result of Network.getResponseBody.
+type NetworkGetResponseBodyReturns struct {
+	// Response body.
+	Body string `json:"body"`
+	// True, if content was sent as base64.
+	Base64Encoded bool `json:"base64Encoded"`
+}
+
+// NetworkGetRequestPostDataParams are the parameters of Network.getRequestPostData. Returns post data sent with the request. Returns an error when no data was sent with the request.
+type NetworkGetRequestPostDataParams struct {
+	// Identifier of the network request to get content for.
+	RequestID NetworkRequestID `json:"requestId"`
+}
+
+// NetworkGetRequestPostDataReturns is the result of Network.getRequestPostData.
+type NetworkGetRequestPostDataReturns struct {
+	// Request body string, omitting files from multipart requests
+	PostData string `json:"postData"`
+}
+
+// NetworkGetResponseBodyForInterceptionParams are the parameters of Network.getResponseBodyForInterception. Returns content served for the given currently intercepted request.
+type NetworkGetResponseBodyForInterceptionParams struct {
+	// Identifier for the intercepted request to get body for.
+	InterceptionID NetworkInterceptionID `json:"interceptionId"`
+}
+
+// NetworkGetResponseBodyForInterceptionReturns is the result of Network.getResponseBodyForInterception.
+type NetworkGetResponseBodyForInterceptionReturns struct {
+	// Response body.
+	Body string `json:"body"`
+	// True, if content was sent as base64.
+	Base64Encoded bool `json:"base64Encoded"`
+}
+
+// NetworkTakeResponseBodyForInterceptionAsStreamParams are the parameters of Network.takeResponseBodyForInterceptionAsStream. Returns a handle to the stream representing the response body. Note that after this command, the intercepted request can't be continued as is -- you either need to cancel it or to provide the response body. The stream only supports sequential read, IO.read will fail if the position is specified.
+type NetworkTakeResponseBodyForInterceptionAsStreamParams struct {
+	InterceptionID NetworkInterceptionID `json:"interceptionId"`
+}
+
+// NetworkTakeResponseBodyForInterceptionAsStreamReturns is the result of Network.takeResponseBodyForInterceptionAsStream.
+type NetworkTakeResponseBodyForInterceptionAsStreamReturns struct {
+	Stream json.RawMessage `json:"stream"`
+}
+
+// NetworkReplayXHRParams are the parameters of Network.replayXHR. This method sends a new XMLHttpRequest which is identical to the original one. The following parameters should be identical: method, url, async, request body, extra headers, withCredentials attribute, user, password.
+type NetworkReplayXHRParams struct {
+	// Identifier of XHR to replay.
+	RequestID NetworkRequestID `json:"requestId"`
+}
+
+// NetworkSearchInResponseBodyParams are the parameters of Network.searchInResponseBody. Searches for given string in response content.
+type NetworkSearchInResponseBodyParams struct {
+	// Identifier of the network response to search.
+	RequestID NetworkRequestID `json:"requestId"`
+	// String to search for.
+	Query string `json:"query"`
+	// If true, search is case sensitive.
+	CaseSensitive bool `json:"caseSensitive,omitempty"`
+	// If true, treats string parameter as regex.
+	IsRegex bool `json:"isRegex,omitempty"`
+}
+
+// NetworkSearchInResponseBodyReturns is the result of Network.searchInResponseBody.
+type NetworkSearchInResponseBodyReturns struct {
+	// List of search matches.
+	Result []DebuggerSearchMatch `json:"result"`
+}
+
+// NetworkSetBlockedURLsParams are the parameters of Network.setBlockedURLs. Blocks URLs from loading.
+type NetworkSetBlockedURLsParams struct {
+	// URL patterns to block. Wildcards ('*') are allowed.
+	Urls []string `json:"urls"`
+}
+
+// NetworkSetBypassServiceWorkerParams are the parameters of Network.setBypassServiceWorker. Toggles ignoring of service worker for each request.
+type NetworkSetBypassServiceWorkerParams struct {
+	// Bypass service worker and load from network.
+	Bypass bool `json:"bypass"`
+}
+
+// NetworkSetCacheDisabledParams are the parameters of Network.setCacheDisabled. Toggles ignoring cache for each request. If `true`, cache will not be used.
+type NetworkSetCacheDisabledParams struct {
+	// Cache disabled state.
+	CacheDisabled bool `json:"cacheDisabled"`
+}
+
+// NetworkSetCookieParams are the parameters of Network.setCookie. Sets a cookie with the given cookie data; may overwrite equivalent cookies if they exist.
+type NetworkSetCookieParams struct {
+	// Cookie name.
+	Name string `json:"name"`
+	// Cookie value.
+	Value string `json:"value"`
+	// The request-URI to associate with the setting of the cookie. This value can affect the default domain, path, source port, and source scheme values of the created cookie.
+	URL string `json:"url,omitempty"`
+	// Cookie domain.
+	Domain string `json:"domain,omitempty"`
+	// Cookie path.
+	Path string `json:"path,omitempty"`
+	// True if cookie is secure.
+	Secure bool `json:"secure,omitempty"`
+	// True if cookie is http-only.
+	HTTPOnly bool `json:"httpOnly,omitempty"`
+	// Cookie SameSite type.
+	SameSite NetworkCookieSameSite `json:"sameSite,omitempty"`
+	// Cookie expiration date, session cookie if not set
+	Expires NetworkTimeSinceEpoch `json:"expires,omitempty"`
+	// Cookie Priority type.
+	Priority NetworkCookiePriority `json:"priority,omitempty"`
+	// True if cookie is SameParty.
+	SameParty bool `json:"sameParty,omitempty"`
+	// Cookie source scheme type.
+	SourceScheme NetworkCookieSourceScheme `json:"sourceScheme,omitempty"`
+	// Cookie source port. Valid values are {-1, [1, 65535]}, -1 indicates an unspecified port. An unspecified port value allows protocol clients to emulate legacy cookie scope for the port. This is a temporary ability and it will be removed in the future.
+	SourcePort int `json:"sourcePort,omitempty"`
+	// Cookie partition key. If not set, the cookie will be set as not partitioned.
+	PartitionKey *NetworkCookiePartitionKey `json:"partitionKey,omitempty"`
+}
+
+// NetworkSetCookieReturns is the result of Network.setCookie.
+type NetworkSetCookieReturns struct {
+	// Always set to true. If an error occurs, the response indicates protocol error.
+	Success bool `json:"success"`
+}
+
+// NetworkSetCookiesParams are the parameters of Network.setCookies. Sets given cookies.
+type NetworkSetCookiesParams struct {
+	// Cookies to be set.
+	Cookies []NetworkCookieParam `json:"cookies"`
+}
+
+// NetworkSetExtraHTTPHeadersParams are the parameters of Network.setExtraHTTPHeaders. Specifies whether to always send extra HTTP headers with the requests from this page.
+type NetworkSetExtraHTTPHeadersParams struct {
+	// Map with extra HTTP headers.
+	Headers NetworkHeaders `json:"headers"`
+}
+
+// NetworkSetAttachDebugStackParams are the parameters of Network.setAttachDebugStack. Specifies whether to attach a page script stack id in requests
+type NetworkSetAttachDebugStackParams struct {
+	// Whether to attach a page script stack for debugging purpose.
+	Enabled bool `json:"enabled"`
+}
+
+// NetworkSetRequestInterceptionParams are the parameters of Network.setRequestInterception. Sets the requests to intercept that match the provided patterns and optionally resource types. Deprecated, please use Fetch.enable instead.
+type NetworkSetRequestInterceptionParams struct {
+	// Requests matching any of these patterns will be forwarded and wait for the corresponding continueInterceptedRequest call.
+	Patterns []NetworkRequestPattern `json:"patterns"`
+}
+
+// NetworkSetUserAgentOverrideParams are the parameters of Network.setUserAgentOverride. Allows overriding user agent with the given string.
+type NetworkSetUserAgentOverrideParams struct {
+	// User agent to use.
+	UserAgent string `json:"userAgent"`
+	// Browser language to emulate.
+	AcceptLanguage string `json:"acceptLanguage,omitempty"`
+	// The platform navigator.platform should return.
+	Platform string `json:"platform,omitempty"`
+	// To be sent in Sec-CH-UA-* headers and returned in navigator.userAgentData
+	UserAgentMetadata json.RawMessage `json:"userAgentMetadata,omitempty"`
+}
+
+// NetworkStreamResourceContentParams are the parameters of Network.streamResourceContent. Enables streaming of the response for the given requestId. If enabled, the dataReceived event contains the data that was received during streaming.
+type NetworkStreamResourceContentParams struct {
+	// Identifier of the request to stream.
+	RequestID NetworkRequestID `json:"requestId"`
+}
+
+// NetworkStreamResourceContentReturns is the result of Network.streamResourceContent.
+type NetworkStreamResourceContentReturns struct {
+	// Data that has been buffered until streaming is enabled. (Encoded as a base64 string when passed over JSON)
+	BufferedData string `json:"bufferedData"`
+}
+
+// NetworkGetSecurityIsolationStatusParams are the parameters of Network.getSecurityIsolationStatus. Returns information about the COEP/COOP isolation status.
+type NetworkGetSecurityIsolationStatusParams struct {
+	// If no frameId is provided, the status of the target is provided.
+	FrameID PageFrameID `json:"frameId,omitempty"`
+}
+
+// NetworkGetSecurityIsolationStatusReturns is the result of Network.getSecurityIsolationStatus.
+type NetworkGetSecurityIsolationStatusReturns struct {
+	Status NetworkSecurityIsolationStatus `json:"status"`
+}
+
+// NetworkEnableReportingAPIParams are the parameters of Network.enableReportingApi. Enables tracking for the Reporting API, events generated by the Reporting API will now be delivered to the client. Enabling triggers 'reportingApiReportAdded' for all existing reports.
+type NetworkEnableReportingAPIParams struct {
+	// Whether to enable or disable events for the Reporting API
+	Enable bool `json:"enable"`
+}
+
+// NetworkLoadNetworkResourceParams are the parameters of Network.loadNetworkResource. Fetches the resource and returns the content.
+type NetworkLoadNetworkResourceParams struct {
+	// Frame id to get the resource for. Mandatory for frame targets, and should be omitted for worker targets.
+	FrameID PageFrameID `json:"frameId,omitempty"`
+	// URL of the resource to get content for.
+	URL string `json:"url"`
+	// Options for the request.
+	Options NetworkLoadNetworkResourceOptions `json:"options"`
+}
+
+// NetworkLoadNetworkResourceReturns is the result of Network.loadNetworkResource.
+type NetworkLoadNetworkResourceReturns struct {
+	Resource NetworkLoadNetworkResourcePageResult `json:"resource"`
+}
+
+// NetworkSetCookieControlsParams are the parameters of Network.setCookieControls. Sets Controls for third-party cookie access Page reload is required before the new cookie behavior will be observed
+type NetworkSetCookieControlsParams struct {
+	// Whether 3pc restriction is enabled.
+	EnableThirdPartyCookieRestriction bool `json:"enableThirdPartyCookieRestriction"`
+	// Whether 3pc grace period exception should be enabled; false by default.
+	DisableThirdPartyCookieMetadata bool `json:"disableThirdPartyCookieMetadata"`
+	// Whether 3pc heuristics exceptions should be enabled; false by default.
+	DisableThirdPartyCookieHeuristics bool `json:"disableThirdPartyCookieHeuristics"`
+}
+
+// NetworkDataReceivedEvent is the Network.dataReceived event. Fired when data chunk was received over the network.
+type NetworkDataReceivedEvent struct {
+	// Request identifier.
+	RequestID NetworkRequestID `json:"requestId"`
+	// Timestamp.
+	Timestamp NetworkMonotonicTime `json:"timestamp"`
+	// Data chunk length.
+	DataLength int `json:"dataLength"`
+	// Actual bytes received (might be less than dataLength for compressed encodings).
+	EncodedDataLength int `json:"encodedDataLength"`
+	// Data that was received. (Encoded as a base64 string when passed over JSON)
+	Data string `json:"data,omitempty"`
+}
+
+// NetworkEventSourceMessageReceivedEvent is the Network.eventSourceMessageReceived event. Fired when EventSource message is received.
+type NetworkEventSourceMessageReceivedEvent struct {
+	// Request identifier.
+	RequestID NetworkRequestID `json:"requestId"`
+	// Timestamp.
+	Timestamp NetworkMonotonicTime `json:"timestamp"`
+	// Message type.
+	EventName string `json:"eventName"`
+	// Message identifier.
+	EventID string `json:"eventId"`
+	// Message content.
+	Data string `json:"data"`
+}
+
+// NetworkLoadingFailedEvent is the Network.loadingFailed event. Fired when HTTP request has failed to load.
+type NetworkLoadingFailedEvent struct {
+	// Request identifier.
+	RequestID NetworkRequestID `json:"requestId"`
+	// Timestamp.
+	Timestamp NetworkMonotonicTime `json:"timestamp"`
+	// Resource type.
+	Type NetworkResourceType `json:"type"`
+	// Error message. List of network errors: https://cs.chromium.org/chromium/src/net/base/net_error_list.h
+	ErrorText string `json:"errorText"`
+	// True if loading was canceled.
+	Canceled bool `json:"canceled,omitempty"`
+	// The reason why loading was blocked, if any.
+	BlockedReason NetworkBlockedReason `json:"blockedReason,omitempty"`
+	// The reason why loading was blocked by CORS, if any.
+	CorsErrorStatus *NetworkCorsErrorStatus `json:"corsErrorStatus,omitempty"`
+}
+
+// NetworkLoadingFinishedEvent is the Network.loadingFinished event. Fired when HTTP request has finished loading.
+type NetworkLoadingFinishedEvent struct {
+	// Request identifier.
+	RequestID NetworkRequestID `json:"requestId"`
+	// Timestamp.
+	Timestamp NetworkMonotonicTime `json:"timestamp"`
+	// Total number of bytes received for this request.
+	EncodedDataLength float64 `json:"encodedDataLength"`
+}
+
+// NetworkRequestInterceptedEvent is the Network.requestIntercepted event. Details of an intercepted HTTP request, which must be either allowed, blocked, modified or mocked. Deprecated, use Fetch.requestPaused instead.
+type NetworkRequestInterceptedEvent struct {
+	// Each request the page makes will have a unique id, however if any redirects are encountered while processing that fetch, they will be reported with the same id as the original fetch. Likewise if HTTP authentication is needed then the same fetch id will be used.
+	InterceptionID NetworkInterceptionID `json:"interceptionId"`
+	Request        NetworkRequest        `json:"request"`
+	// The id of the frame that initiated the request.
+	FrameID PageFrameID `json:"frameId"`
+	// How the requested resource will be used.
+	ResourceType NetworkResourceType `json:"resourceType"`
+	// Whether this is a navigation request, which can abort the navigation completely.
+	IsNavigationRequest bool `json:"isNavigationRequest"`
+	// Set if the request is a navigation that will result in a download. Only present after response is received from the server (i.e. HeadersReceived stage).
+	IsDownload bool `json:"isDownload,omitempty"`
+	// Redirect location, only sent if a redirect was intercepted.
+	RedirectURL string `json:"redirectUrl,omitempty"`
+	// Details of the Authorization Challenge encountered. If this is set then continueInterceptedRequest must contain an authChallengeResponse.
+	AuthChallenge *NetworkAuthChallenge `json:"authChallenge,omitempty"`
+	// Response error if intercepted at response stage or if redirect occurred while intercepting request.
+	ResponseErrorReason NetworkErrorReason `json:"responseErrorReason,omitempty"`
+	// Response code if intercepted at response stage or if redirect occurred while intercepting request or auth retry occurred.
+	ResponseStatusCode int `json:"responseStatusCode,omitempty"`
+	// Response headers if intercepted at the response stage or if redirect occurred while intercepting request or auth retry occurred.
+	ResponseHeaders NetworkHeaders `json:"responseHeaders,omitempty"`
+	// If the intercepted request had a corresponding requestWillBeSent event fired for it, then this requestId will be the same as the requestId present in the requestWillBeSent event.
+	RequestID NetworkRequestID `json:"requestId,omitempty"`
+}
+
+// NetworkRequestServedFromCacheEvent is the Network.requestServedFromCache event. Fired if request ended up loading from cache.
+type NetworkRequestServedFromCacheEvent struct {
+	// Request identifier.
+	RequestID NetworkRequestID `json:"requestId"`
+}
+
+// NetworkRequestWillBeSentEvent is the Network.requestWillBeSent event. Fired when page is about to send HTTP request.
+type NetworkRequestWillBeSentEvent struct {
+	// Request identifier.
+	RequestID NetworkRequestID `json:"requestId"`
+	// Loader identifier. Empty string if the request is fetched from worker.
+	LoaderID NetworkLoaderID `json:"loaderId"`
+	// URL of the document this request is loaded for.
+	DocumentURL string `json:"documentURL"`
+	// Request data.
+	Request NetworkRequest `json:"request"`
+	// Timestamp.
+	Timestamp NetworkMonotonicTime `json:"timestamp"`
+	// Timestamp.
+	WallTime NetworkTimeSinceEpoch `json:"wallTime"`
+	// Request initiator.
+	Initiator NetworkInitiator `json:"initiator"`
+	// In the case that redirectResponse is populated, this flag indicates whether requestWillBeSentExtraInfo and responseReceivedExtraInfo events will be or were emitted for the request which was just redirected.
+	RedirectHasExtraInfo bool `json:"redirectHasExtraInfo"`
+	// Redirect response data.
+	RedirectResponse *NetworkResponse `json:"redirectResponse,omitempty"`
+	// Type of this resource.
+	Type NetworkResourceType `json:"type,omitempty"`
+	// Frame identifier.
+	FrameID PageFrameID `json:"frameId,omitempty"`
+	// Whether the request is initiated by a user gesture. Defaults to false.
+	HasUserGesture bool `json:"hasUserGesture,omitempty"`
+}
+
+// NetworkResourceChangedPriorityEvent is the Network.resourceChangedPriority event. Fired when resource loading priority is changed
+type NetworkResourceChangedPriorityEvent struct {
+	// Request identifier.
+	RequestID NetworkRequestID `json:"requestId"`
+	// New priority
+	NewPriority NetworkResourcePriority `json:"newPriority"`
+	// Timestamp.
+	Timestamp NetworkMonotonicTime `json:"timestamp"`
+}
+
+// NetworkSignedExchangeReceivedEvent is the Network.signedExchangeReceived event. Fired when a signed exchange was received over the network
+type NetworkSignedExchangeReceivedEvent struct {
+	// Request identifier.
+	RequestID NetworkRequestID `json:"requestId"`
+	// Information about the signed exchange response.
+	Info NetworkSignedExchangeInfo `json:"info"`
+}
+
+// NetworkResponseReceivedEvent is the Network.responseReceived event. Fired when HTTP response is available.
+type NetworkResponseReceivedEvent struct {
+	// Request identifier.
+	RequestID NetworkRequestID `json:"requestId"`
+	// Loader identifier. Empty string if the request is fetched from worker.
+	LoaderID NetworkLoaderID `json:"loaderId"`
+	// Timestamp.
+	Timestamp NetworkMonotonicTime `json:"timestamp"`
+	// Resource type.
+	Type NetworkResourceType `json:"type"`
+	// Response data.
+	Response NetworkResponse `json:"response"`
+	// Indicates whether requestWillBeSentExtraInfo and responseReceivedExtraInfo events will be or were emitted for this request.
+	HasExtraInfo bool `json:"hasExtraInfo"`
+	// Frame identifier.
+	FrameID PageFrameID `json:"frameId,omitempty"`
+}
+
+// NetworkWebSocketClosedEvent is the Network.webSocketClosed event. Fired when WebSocket is closed.
+type NetworkWebSocketClosedEvent struct {
+	// Request identifier.
+	RequestID NetworkRequestID `json:"requestId"`
+	// Timestamp.
+	Timestamp NetworkMonotonicTime `json:"timestamp"`
+}
+
+// NetworkWebSocketCreatedEvent is the Network.webSocketCreated event. Fired upon WebSocket creation.
+type NetworkWebSocketCreatedEvent struct {
+	// Request identifier.
+	RequestID NetworkRequestID `json:"requestId"`
+	// WebSocket request URL.
+	URL string `json:"url"`
+	// Request initiator.
+	Initiator *NetworkInitiator `json:"initiator,omitempty"`
+}
+
+// NetworkWebSocketFrameErrorEvent is the Network.webSocketFrameError event. Fired when WebSocket message error occurs.
+type NetworkWebSocketFrameErrorEvent struct {
+	// Request identifier.
+	RequestID NetworkRequestID `json:"requestId"`
+	// Timestamp.
+	Timestamp NetworkMonotonicTime `json:"timestamp"`
+	// WebSocket error message.
+	ErrorMessage string `json:"errorMessage"`
+}
+
+// NetworkWebSocketFrameReceivedEvent is the Network.webSocketFrameReceived event. Fired when WebSocket message is received.
+type NetworkWebSocketFrameReceivedEvent struct {
+	// Request identifier.
+	RequestID NetworkRequestID `json:"requestId"`
+	// Timestamp.
+	Timestamp NetworkMonotonicTime `json:"timestamp"`
+	// WebSocket response data.
+	Response NetworkWebSocketFrame `json:"response"`
+}
+
+// NetworkWebSocketFrameSentEvent is the Network.webSocketFrameSent event. Fired when WebSocket message is sent.
+type NetworkWebSocketFrameSentEvent struct {
+	// Request identifier.
+	RequestID NetworkRequestID `json:"requestId"`
+	// Timestamp.
+	Timestamp NetworkMonotonicTime `json:"timestamp"`
+	// WebSocket response data.
+	Response NetworkWebSocketFrame `json:"response"`
+}
+
+// NetworkWebSocketHandshakeResponseReceivedEvent is the Network.webSocketHandshakeResponseReceived event. Fired when WebSocket handshake response becomes available.
+type NetworkWebSocketHandshakeResponseReceivedEvent struct {
+	// Request identifier.
+	RequestID NetworkRequestID `json:"requestId"`
+	// Timestamp.
+	Timestamp NetworkMonotonicTime `json:"timestamp"`
+	// WebSocket response data.
+	Response NetworkWebSocketResponse `json:"response"`
+}
+
+// NetworkWebSocketWillSendHandshakeRequestEvent is the Network.webSocketWillSendHandshakeRequest event. Fired when WebSocket is about to initiate handshake.
+type NetworkWebSocketWillSendHandshakeRequestEvent struct {
+	// Request identifier.
+	RequestID NetworkRequestID `json:"requestId"`
+	// Timestamp.
+	Timestamp NetworkMonotonicTime `json:"timestamp"`
+	// UTC Timestamp.
+	WallTime NetworkTimeSinceEpoch `json:"wallTime"`
+	// WebSocket request data.
+	Request NetworkWebSocketRequest `json:"request"`
+}
+
+// NetworkWebTransportCreatedEvent is the Network.webTransportCreated event. Fired upon WebTransport creation.
+type NetworkWebTransportCreatedEvent struct {
+	// WebTransport identifier.
+	TransportID NetworkRequestID `json:"transportId"`
+	// WebTransport request URL.
+	URL string `json:"url"`
+	// Timestamp.
+	Timestamp NetworkMonotonicTime `json:"timestamp"`
+	// Request initiator.
+	Initiator *NetworkInitiator `json:"initiator,omitempty"`
+}
+
+// NetworkWebTransportConnectionEstablishedEvent is the Network.webTransportConnectionEstablished event. Fired when WebTransport handshake is finished.
+type NetworkWebTransportConnectionEstablishedEvent struct {
+	// WebTransport identifier.
+	TransportID NetworkRequestID `json:"transportId"`
+	// Timestamp.
+	Timestamp NetworkMonotonicTime `json:"timestamp"`
+}
+
+// NetworkWebTransportClosedEvent is the Network.webTransportClosed event. Fired when WebTransport is disposed.
+type NetworkWebTransportClosedEvent struct {
+	// WebTransport identifier.
+	TransportID NetworkRequestID `json:"transportId"`
+	// Timestamp.
+	Timestamp NetworkMonotonicTime `json:"timestamp"`
+}
+
+// NetworkDirectTCPSocketCreatedEvent is the Network.directTCPSocketCreated event. Fired upon direct_socket.TCPSocket creation.
+type NetworkDirectTCPSocketCreatedEvent struct {
+	Identifier NetworkRequestID `json:"identifier"`
+	RemoteAddr string           `json:"remoteAddr"`
+	// Unsigned int 16.
+	RemotePort int                           `json:"remotePort"`
+	Options    NetworkDirectTCPSocketOptions `json:"options"`
+	Timestamp  NetworkMonotonicTime          `json:"timestamp"`
+	Initiator  *NetworkInitiator             `json:"initiator,omitempty"`
+}
+
+// NetworkDirectTCPSocketOpenedEvent is the Network.directTCPSocketOpened event. Fired when direct_socket.TCPSocket connection is opened.
+type NetworkDirectTCPSocketOpenedEvent struct {
+	Identifier NetworkRequestID `json:"identifier"`
+	RemoteAddr string           `json:"remoteAddr"`
+	// Expected to be unsigned integer.
+	RemotePort int                  `json:"remotePort"`
+	Timestamp  NetworkMonotonicTime `json:"timestamp"`
+	LocalAddr  string               `json:"localAddr,omitempty"`
+	// Expected to be unsigned integer.
+	LocalPort int `json:"localPort,omitempty"`
+}
+
+// NetworkDirectTCPSocketAbortedEvent is the Network.directTCPSocketAborted event. Fired when direct_socket.TCPSocket is aborted.
+type NetworkDirectTCPSocketAbortedEvent struct {
+	Identifier   NetworkRequestID     `json:"identifier"`
+	ErrorMessage string               `json:"errorMessage"`
+	Timestamp    NetworkMonotonicTime `json:"timestamp"`
+}
+
+// NetworkDirectTCPSocketClosedEvent is the Network.directTCPSocketClosed event. Fired when direct_socket.TCPSocket is closed.
+type NetworkDirectTCPSocketClosedEvent struct {
+	Identifier NetworkRequestID     `json:"identifier"`
+	Timestamp  NetworkMonotonicTime `json:"timestamp"`
+}
+
+// NetworkDirectTCPSocketChunkSentEvent is the Network.directTCPSocketChunkSent event. Fired when data is sent to tcp direct socket stream.
+type NetworkDirectTCPSocketChunkSentEvent struct {
+	Identifier NetworkRequestID     `json:"identifier"`
+	Data       string               `json:"data"`
+	Timestamp  NetworkMonotonicTime `json:"timestamp"`
+}
+
+// NetworkDirectTCPSocketChunkReceivedEvent is the Network.directTCPSocketChunkReceived event. Fired when data is received from tcp direct socket stream.
+type NetworkDirectTCPSocketChunkReceivedEvent struct {
+	Identifier NetworkRequestID     `json:"identifier"`
+	Data       string               `json:"data"`
+	Timestamp  NetworkMonotonicTime `json:"timestamp"`
+}
+
+// NetworkDirectUDPSocketCreatedEvent is the Network.directUDPSocketCreated event. Fired upon direct_socket.UDPSocket creation.
+type NetworkDirectUDPSocketCreatedEvent struct {
+	Identifier NetworkRequestID              `json:"identifier"`
+	Options    NetworkDirectUDPSocketOptions `json:"options"`
+	Timestamp  NetworkMonotonicTime          `json:"timestamp"`
+	Initiator  *NetworkInitiator             `json:"initiator,omitempty"`
+}
+
+// NetworkDirectUDPSocketOpenedEvent is the Network.directUDPSocketOpened event. Fired when direct_socket.UDPSocket connection is opened.
+type NetworkDirectUDPSocketOpenedEvent struct {
+	Identifier NetworkRequestID `json:"identifier"`
+	LocalAddr  string           `json:"localAddr"`
+	// Expected to be unsigned integer.
+	LocalPort  int                  `json:"localPort"`
+	Timestamp  NetworkMonotonicTime `json:"timestamp"`
+	RemoteAddr string               `json:"remoteAddr,omitempty"`
+	// Expected to be unsigned integer.
+	RemotePort int `json:"remotePort,omitempty"`
+}
+
+// NetworkDirectUDPSocketAbortedEvent is the Network.directUDPSocketAborted event. Fired when direct_socket.UDPSocket is aborted.
+type NetworkDirectUDPSocketAbortedEvent struct {
+	Identifier   NetworkRequestID     `json:"identifier"`
+	ErrorMessage string               `json:"errorMessage"`
+	Timestamp    NetworkMonotonicTime `json:"timestamp"`
+}
+
+// NetworkDirectUDPSocketClosedEvent is the Network.directUDPSocketClosed event. Fired when direct_socket.UDPSocket is closed.
+type NetworkDirectUDPSocketClosedEvent struct {
+	Identifier NetworkRequestID     `json:"identifier"`
+	Timestamp  NetworkMonotonicTime `json:"timestamp"`
+}
+
+// NetworkDirectUDPSocketChunkSentEvent is the Network.directUDPSocketChunkSent event. Fired when message is sent to udp direct socket stream.
+type NetworkDirectUDPSocketChunkSentEvent struct {
+	Identifier NetworkRequestID        `json:"identifier"`
+	Message    NetworkDirectUDPMessage `json:"message"`
+	Timestamp  NetworkMonotonicTime    `json:"timestamp"`
+}
+
+// NetworkDirectUDPSocketChunkReceivedEvent is the Network.directUDPSocketChunkReceived event. Fired when message is received from udp direct socket stream.
+type NetworkDirectUDPSocketChunkReceivedEvent struct {
+	Identifier NetworkRequestID        `json:"identifier"`
+	Message    NetworkDirectUDPMessage `json:"message"`
+	Timestamp  NetworkMonotonicTime    `json:"timestamp"`
+}
+
+// NetworkRequestWillBeSentExtraInfoEvent is the Network.requestWillBeSentExtraInfo event. Fired when additional information about a requestWillBeSent event is available from the network stack. Not every requestWillBeSent event will have an additional requestWillBeSentExtraInfo fired for it, and there is no guarantee whether requestWillBeSent or requestWillBeSentExtraInfo will be fired first for the same request.
+type NetworkRequestWillBeSentExtraInfoEvent struct {
+	// Request identifier. Used to match this information to an existing requestWillBeSent event.
+	RequestID NetworkRequestID `json:"requestId"`
+	// A list of cookies potentially associated to the requested URL. This includes both cookies sent with the request and the ones not sent; the latter are distinguished by having blockedReasons field set.
+	AssociatedCookies []NetworkAssociatedCookie `json:"associatedCookies"`
+	// Raw request headers as they will be sent over the wire.
+	Headers NetworkHeaders `json:"headers"`
+	// Connection timing information for the request.
+	ConnectTiming NetworkConnectTiming `json:"connectTiming"`
+	// The client security state set for the request.
+	ClientSecurityState *NetworkClientSecurityState `json:"clientSecurityState,omitempty"`
+	// Whether the site has partitioned cookies stored in a partition different than the current one.
+	SiteHasCookieInOtherPartition bool `json:"siteHasCookieInOtherPartition,omitempty"`
+}
+
+// NetworkResponseReceivedExtraInfoEvent is the Network.responseReceivedExtraInfo event. Fired when additional information about a responseReceived event is available from the network stack. Not every responseReceived event will have an additional responseReceivedExtraInfo for it, and responseReceivedExtraInfo may be fired before or after responseReceived.
+type NetworkResponseReceivedExtraInfoEvent struct {
+	// Request identifier. Used to match this information to another responseReceived event.
+	RequestID NetworkRequestID `json:"requestId"`
+	// A list of cookies which were not stored from the response along with the corresponding reasons for blocking. The cookies here may not be valid due to syntax errors, which are represented by the invalid cookie line string instead of a proper cookie.
+	BlockedCookies []NetworkBlockedSetCookieWithReason `json:"blockedCookies"`
+	// Raw response headers as they were received over the wire. Duplicate headers in the response are represented as a single key with their values concatentated using `\n` as the separator. See also `headersText` that contains verbatim text for HTTP/1.*.
+	Headers NetworkHeaders `json:"headers"`
+	// The IP address space of the resource. The address space can only be determined once the transport established the connection, so we can't send it in `requestWillBeSentExtraInfo`.
+	ResourceIPAddressSpace NetworkIPAddressSpace `json:"resourceIPAddressSpace"`
+	// The status code of the response. This is useful in cases the request failed and no responseReceived event is triggered, which is the case for, e.g., CORS errors. This is also the correct status code for cached requests, where the status in responseReceived is a 200 and this will be 304.
+	StatusCode int `json:"statusCode"`
+	// Raw response header text as it was received over the wire. The raw text may not always be available, such as in the case of HTTP/2 or QUIC.
+	HeadersText string `json:"headersText,omitempty"`
+	// The cookie partition key that will be used to store partitioned cookies set in this response. Only sent when partitioned cookies are enabled.
+	CookiePartitionKey *NetworkCookiePartitionKey `json:"cookiePartitionKey,omitempty"`
+	// True if partitioned cookies are enabled, but the partition key is not serializable to string.
+	CookiePartitionKeyOpaque bool `json:"cookiePartitionKeyOpaque,omitempty"`
+	// A list of cookies which should have been blocked by 3PCD but are exempted and stored from the response with the corresponding reason.
+	ExemptedCookies []NetworkExemptedSetCookieWithReason `json:"exemptedCookies,omitempty"`
+}
+
+// NetworkResponseReceivedEarlyHintsEvent is the Network.responseReceivedEarlyHints event. Fired when 103 Early Hints headers is received in addition to the common response. Not every responseReceived event will have an responseReceivedEarlyHints fired. Only one responseReceivedEarlyHints may be fired for eached responseReceived event.
+type NetworkResponseReceivedEarlyHintsEvent struct {
+	// Request identifier. Used to match this information to another responseReceived event.
+	RequestID NetworkRequestID `json:"requestId"`
+	// Raw response headers as they were received over the wire. Duplicate headers in the response are represented as a single key with their values concatentated using `\n` as the separator. See also `headersText` that contains verbatim text for HTTP/1.*.
+	Headers NetworkHeaders `json:"headers"`
+}
+
+// NetworkTrustTokenOperationDoneEvent is the Network.trustTokenOperationDone event. Fired exactly once for each Trust Token operation. Depending on the type of the operation and whether the operation succeeded or failed, the event is fired before the corresponding request was sent or after the response was received.
+type NetworkTrustTokenOperationDoneEvent struct {
+	// Detailed success or error status of the operation. 'AlreadyExists' also signifies a successful operation, as the result of the operation already exists und thus, the operation was abort preemptively (e.g. a cache hit).
+	Status    string                         `json:"status"`
+	Type      NetworkTrustTokenOperationType `json:"type"`
+	RequestID NetworkRequestID               `json:"requestId"`
+	// Top level origin. The context in which the operation was attempted.
+	TopLevelOrigin string `json:"topLevelOrigin,omitempty"`
+	// Origin of the issuer in case of a "Issuance" or "Redemption" operation.
+	IssuerOrigin string `json:"issuerOrigin,omitempty"`
+	// The number of obtained Trust Tokens on a successful "Issuance" operation.
+	IssuedTokenCount int `json:"issuedTokenCount,omitempty"`
+}
+
+// NetworkPolicyUpdatedEvent is the Network.policyUpdated event. Fired once security policy has been updated.
+type NetworkPolicyUpdatedEvent struct {
+}
+
+// NetworkSubresourceWebBundleMetadataReceivedEvent is the Network.subresourceWebBundleMetadataReceived event. Fired once when parsing the .wbn file has succeeded. The event contains the information about the web bundle contents.
+type NetworkSubresourceWebBundleMetadataReceivedEvent struct {
+	// Request identifier. Used to match this information to another event.
+	RequestID NetworkRequestID `json:"requestId"`
+	// A list of URLs of resources in the subresource Web Bundle.
+	Urls []string `json:"urls"`
+}
+
+// NetworkSubresourceWebBundleMetadataErrorEvent is the Network.subresourceWebBundleMetadataError event. Fired once when parsing the .wbn file has failed.
+type NetworkSubresourceWebBundleMetadataErrorEvent struct {
+	// Request identifier. Used to match this information to another event.
+	RequestID NetworkRequestID `json:"requestId"`
+	// Error message
+	ErrorMessage string `json:"errorMessage"`
+}
+
+// NetworkSubresourceWebBundleInnerResponseParsedEvent is the Network.subresourceWebBundleInnerResponseParsed event. Fired when handling requests for resources within a .wbn file. Note: this will only be fired for resources that are requested by the webpage.
+type NetworkSubresourceWebBundleInnerResponseParsedEvent struct {
+	// Request identifier of the subresource request
+	InnerRequestID NetworkRequestID `json:"innerRequestId"`
+	// URL of the subresource resource.
+	InnerRequestURL string `json:"innerRequestURL"`
+	// Bundle request identifier. Used to match this information to another event. This made be absent in case when the instrumentation was enabled only after webbundle was parsed.
+	BundleRequestID NetworkRequestID `json:"bundleRequestId,omitempty"`
+}
+
+// NetworkSubresourceWebBundleInnerResponseErrorEvent is the Network.subresourceWebBundleInnerResponseError event. Fired when request for resources within a .wbn file failed.
+type NetworkSubresourceWebBundleInnerResponseErrorEvent struct {
+	// Request identifier of the subresource request
+	InnerRequestID NetworkRequestID `json:"innerRequestId"`
+	// URL of the subresource resource.
+	InnerRequestURL string `json:"innerRequestURL"`
+	// Error message
+	ErrorMessage string `json:"errorMessage"`
+	// Bundle request identifier. Used to match this information to another event. This made be absent in case when the instrumentation was enabled only after webbundle was parsed.
+	BundleRequestID NetworkRequestID `json:"bundleRequestId,omitempty"`
+}
+
+// NetworkReportingAPIReportAddedEvent is the Network.reportingApiReportAdded event. Is sent whenever a new report is added. And after 'enableReportingApi' for all existing reports.
+type NetworkReportingAPIReportAddedEvent struct {
+	Report NetworkReportingAPIReport `json:"report"`
+}
+
+// NetworkReportingAPIReportUpdatedEvent is the Network.reportingApiReportUpdated event.
+type NetworkReportingAPIReportUpdatedEvent struct {
+	Report NetworkReportingAPIReport `json:"report"`
+}
+
+// NetworkReportingAPIEndpointsChangedForOriginEvent is the Network.reportingApiEndpointsChangedForOrigin event.
+type NetworkReportingAPIEndpointsChangedForOriginEvent struct {
+	// Origin of the document(s) which configured the endpoints.
+	Origin    string                        `json:"origin"`
+	Endpoints []NetworkReportingAPIEndpoint `json:"endpoints"`
+}
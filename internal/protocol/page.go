@@ -0,0 +1,1313 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package protocol
+
+import (
+	"encoding/json"
+)
+
+// Page domain: Actions and events related to the inspected page belong to the page domain.
+
+const (
+	CommandPageAddScriptToEvaluateOnLoad           = "Page.addScriptToEvaluateOnLoad"
+	CommandPageAddScriptToEvaluateOnNewDocument    = "Page.addScriptToEvaluateOnNewDocument"
+	CommandPageBringToFront                        = "Page.bringToFront"
+	CommandPageCaptureScreenshot                   = "Page.captureScreenshot"
+	CommandPageCaptureSnapshot                     = "Page.captureSnapshot"
+	CommandPageClearDeviceMetricsOverride          = "Page.clearDeviceMetricsOverride"
+	CommandPageClearDeviceOrientationOverride      = "Page.clearDeviceOrientationOverride"
+	CommandPageClearGeolocationOverride            = "Page.clearGeolocationOverride"
+	CommandPageCreateIsolatedWorld                 = "Page.createIsolatedWorld"
+	CommandPageDeleteCookie                        = "Page.deleteCookie"
+	CommandPageDisable                             = "Page.disable"
+	CommandPageEnable                              = "Page.enable"
+	CommandPageGetAppManifest                      = "Page.getAppManifest"
+	CommandPageGetInstallabilityErrors             = "Page.getInstallabilityErrors"
+	CommandPageGetManifestIcons                    = "Page.getManifestIcons"
+	CommandPageGetAppID                            = "Page.getAppId"
+	CommandPageGetAdScriptAncestry                 = "Page.getAdScriptAncestry"
+	CommandPageGetFrameTree                        = "Page.getFrameTree"
+	CommandPageGetLayoutMetrics                    = "Page.getLayoutMetrics"
+	CommandPageGetNavigationHistory                = "Page.getNavigationHistory"
+	CommandPageResetNavigationHistory              = "Page.resetNavigationHistory"
+	CommandPageGetResourceContent                  = "Page.getResourceContent"
+	CommandPageGetResourceTree                     = "Page.getResourceTree"
+	CommandPageHandleJavaScriptDialog              = "Page.handleJavaScriptDialog"
+	CommandPageNavigate                            = "Page.navigate"
+	CommandPageNavigateToHistoryEntry              = "Page.navigateToHistoryEntry"
+	CommandPagePrintToPDF                          = "Page.printToPDF"
+	CommandPageReload                              = "Page.reload"
+	CommandPageRemoveScriptToEvaluateOnLoad        = "Page.removeScriptToEvaluateOnLoad"
+	CommandPageRemoveScriptToEvaluateOnNewDocument = "Page.removeScriptToEvaluateOnNewDocument"
+	CommandPageScreencastFrameAck                  = "Page.screencastFrameAck"
+	CommandPageSearchInResource                    = "Page.searchInResource"
+	CommandPageSetAdBlockingEnabled                = "Page.setAdBlockingEnabled"
+	CommandPageSetBypassCSP                        = "Page.setBypassCSP"
+	CommandPageGetPermissionsPolicyState           = "Page.getPermissionsPolicyState"
+	CommandPageGetOriginTrials                     = "Page.getOriginTrials"
+	CommandPageSetDeviceMetricsOverride            = "Page.setDeviceMetricsOverride"
+	CommandPageSetDeviceOrientationOverride        = "Page.setDeviceOrientationOverride"
+	CommandPageSetFontFamilies                     = "Page.setFontFamilies"
+	CommandPageSetFontSizes                        = "Page.setFontSizes"
+	CommandPageSetDocumentContent                  = "Page.setDocumentContent"
+	CommandPageSetDownloadBehavior                 = "Page.setDownloadBehavior"
+	CommandPageSetGeolocationOverride              = "Page.setGeolocationOverride"
+	CommandPageSetLifecycleEventsEnabled           = "Page.setLifecycleEventsEnabled"
+	CommandPageSetTouchEmulationEnabled            = "Page.setTouchEmulationEnabled"
+	CommandPageStartScreencast                     = "Page.startScreencast"
+	CommandPageStopLoading                         = "Page.stopLoading"
+	CommandPageCrash                               = "Page.crash"
+	CommandPageClose                               = "Page.close"
+	CommandPageSetWebLifecycleState                = "Page.setWebLifecycleState"
+	CommandPageStopScreencast                      = "Page.stopScreencast"
+	CommandPageProduceCompilationCache             = "Page.produceCompilationCache"
+	CommandPageAddCompilationCache                 = "Page.addCompilationCache"
+	CommandPageClearCompilationCache               = "Page.clearCompilationCache"
+	CommandPageSetSPCTransactionMode               = "Page.setSPCTransactionMode"
+	CommandPageSetRPHRegistrationMode              = "Page.setRPHRegistrationMode"
+	CommandPageGenerateTestReport                  = "Page.generateTestReport"
+	CommandPageWaitForDebugger                     = "Page.waitForDebugger"
+	CommandPageSetInterceptFileChooserDialog       = "Page.setInterceptFileChooserDialog"
+	CommandPageSetPrerenderingAllowed              = "Page.setPrerenderingAllowed"
+	EventPageDOMContentEventFired                  = "Page.domContentEventFired"
+	EventPageFileChooserOpened                     = "Page.fileChooserOpened"
+	EventPageFrameAttached                         = "Page.frameAttached"
+	EventPageFrameClearedScheduledNavigation       = "Page.frameClearedScheduledNavigation"
+	EventPageFrameDetached                         = "Page.frameDetached"
+	EventPageFrameSubtreeWillBeDetached            = "Page.frameSubtreeWillBeDetached"
+	EventPageFrameNavigated                        = "Page.frameNavigated"
+	EventPageDocumentOpened                        = "Page.documentOpened"
+	EventPageFrameResized                          = "Page.frameResized"
+	EventPageFrameStartedNavigating                = "Page.frameStartedNavigating"
+	EventPageFrameRequestedNavigation              = "Page.frameRequestedNavigation"
+	EventPageFrameScheduledNavigation              = "Page.frameScheduledNavigation"
+	EventPageFrameStartedLoading                   = "Page.frameStartedLoading"
+	EventPageFrameStoppedLoading                   = "Page.frameStoppedLoading"
+	EventPageDownloadWillBegin                     = "Page.downloadWillBegin"
+	EventPageDownloadProgress                      = "Page.downloadProgress"
+	EventPageInterstitialHidden                    = "Page.interstitialHidden"
+	EventPageInterstitialShown                     = "Page.interstitialShown"
+	EventPageJavascriptDialogClosed                = "Page.javascriptDialogClosed"
+	EventPageJavascriptDialogOpening               = "Page.javascriptDialogOpening"
+	EventPageLifecycleEvent                        = "Page.lifecycleEvent"
+	EventPageBackForwardCacheNotUsed               = "Page.backForwardCacheNotUsed"
+	EventPageLoadEventFired                        = "Page.loadEventFired"
+	EventPageNavigatedWithinDocument               = "Page.navigatedWithinDocument"
+	EventPageScreencastFrame                       = "Page.screencastFrame"
+	EventPageScreencastVisibilityChanged           = "Page.screencastVisibilityChanged"
+	EventPageWindowOpen                            = "Page.windowOpen"
+	EventPageCompilationCacheProduced              = "Page.compilationCacheProduced"
+)
+
+// PageFrameID: Unique frame identifier.
+type PageFrameID = string
+
+// PageAdFrameType: Indicates whether a frame has been identified as an ad.
+type PageAdFrameType = string
+
+type PageAdFrameExplanation = string
+
+// PageAdFrameStatus: Indicates whether a frame has been identified as an ad and why.
+type PageAdFrameStatus struct {
+	AdFrameType  PageAdFrameType          `json:"adFrameType"`
+	Explanations []PageAdFrameExplanation `json:"explanations,omitempty"`
+}
+
+// PageAdScriptID: Identifies the script which caused a script or frame to be labelled as an ad.
+type PageAdScriptID struct {
+	// Script Id of the script which caused a script or frame to be labelled as an ad.
+	ScriptID RuntimeScriptID `json:"scriptId"`
+	// Id of scriptId's debugger.
+	DebuggerID RuntimeUniqueDebuggerID `json:"debuggerId"`
+}
+
+// PageAdScriptAncestry: Encapsulates the script ancestry and the root script filterlist rule that caused the frame to be labelled as an ad. Only created when `ancestryChain` is not empty.
+type PageAdScriptAncestry struct {
+	// A chain of `AdScriptId`s representing the ancestry of an ad script that led to the creation of a frame. The chain is ordered from the script itself (lower level) up to its root ancestor that was flagged by filterlist.
+	AncestryChain []PageAdScriptID `json:"ancestryChain"`
+	// The filterlist rule that caused the root (last) script in `ancestryChain` to be ad-tagged. Only populated if the rule is available.
+	RootScriptFilterlistRule string `json:"rootScriptFilterlistRule,omitempty"`
+}
+
+// PageSecureContextType: Indicates whether the frame is a secure context and why it is the case.
+type PageSecureContextType = string
+
+// PageCrossOriginIsolatedContextType: Indicates whether the frame is cross-origin isolated and why it is the case.
+type PageCrossOriginIsolatedContextType = string
+
+type PageGatedAPIFeatures = string
+
+// PagePermissionsPolicyFeature: All Permissions Policy features. This enum should match the one defined in services/network/public/cpp/permissions_policy/permissions_policy_features.json5. LINT.IfChange(PermissionsPolicyFeature)
+type PagePermissionsPolicyFeature = string
+
+// PagePermissionsPolicyBlockReason: Reason for a permissions policy feature to be disabled.
+type PagePermissionsPolicyBlockReason = string
+
+type PagePermissionsPolicyBlockLocator struct {
+	FrameID     PageFrameID                      `json:"frameId"`
+	BlockReason PagePermissionsPolicyBlockReason `json:"blockReason"`
+}
+
+type PagePermissionsPolicyFeatureState struct {
+	Feature PagePermissionsPolicyFeature       `json:"feature"`
+	Allowed bool                               `json:"allowed"`
+	Locator *PagePermissionsPolicyBlockLocator `json:"locator,omitempty"`
+}
+
+// PageOriginTrialTokenStatus: Origin Trial(https://www.chromium.org/blink/origin-trials) support. Status for an Origin Trial token.
+type PageOriginTrialTokenStatus = string
+
+// PageOriginTrialStatus: Status for an Origin Trial.
+type PageOriginTrialStatus = string
+
+type PageOriginTrialUsageRestriction = string
+
+type PageOriginTrialToken struct {
+	Origin           string                          `json:"origin"`
+	MatchSubDomains  bool                            `json:"matchSubDomains"`
+	TrialName        string                          `json:"trialName"`
+	ExpiryTime       NetworkTimeSinceEpoch           `json:"expiryTime"`
+	IsThirdParty     bool                            `json:"isThirdParty"`
+	UsageRestriction PageOriginTrialUsageRestriction `json:"usageRestriction"`
+}
+
+type PageOriginTrialTokenWithStatus struct {
+	RawTokenText string `json:"rawTokenText"`
+	// `parsedToken` is present only when the token is extractable and parsable.
+	ParsedToken *PageOriginTrialToken      `json:"parsedToken,omitempty"`
+	Status      PageOriginTrialTokenStatus `json:"status"`
+}
+
+type PageOriginTrial struct {
+	TrialName        string                           `json:"trialName"`
+	Status           PageOriginTrialStatus            `json:"status"`
+	TokensWithStatus []PageOriginTrialTokenWithStatus `json:"tokensWithStatus"`
+}
+
+// PageSecurityOriginDetails: Additional information about the frame document's security origin.
+type PageSecurityOriginDetails struct {
+	// Indicates whether the frame document's security origin is one of the local hostnames (e.g. "localhost") or IP addresses (IPv4 127.0.0.0/8 or IPv6 ::1).
+	IsLocalhost bool `json:"isLocalhost"`
+}
+
+// PageFrame: Information about the Frame on the page.
+type PageFrame struct {
+	// Frame unique identifier.
+	ID PageFrameID `json:"id"`
+	// Parent frame identifier.
+	ParentID PageFrameID `json:"parentId,omitempty"`
+	// Identifier of the loader associated with this frame.
+	LoaderID NetworkLoaderID `json:"loaderId"`
+	// Frame's name as specified in the tag.
+	Name string `json:"name,omitempty"`
+	// Frame document's URL without fragment.
+	URL string `json:"url"`
+	// Frame document's URL fragment including the '#'.
+	URLFragment string `json:"urlFragment,omitempty"`
+	// Frame document's registered domain, taking the public suffixes list into account. Extracted from the Frame's url. Example URLs: http://www.google.com/file.html -> "google.com" http://a.b.co.uk/file.html -> "b.co.uk"
+	DomainAndRegistry string `json:"domainAndRegistry"`
+	// Frame document's security origin.
+	SecurityOrigin string `json:"securityOrigin"`
+	// Additional details about the frame document's security origin.
+	SecurityOriginDetails *PageSecurityOriginDetails `json:"securityOriginDetails,omitempty"`
+	// Frame document's mimeType as determined by the browser.
+	MimeType string `json:"mimeType"`
+	// If the frame failed to load, this contains the URL that could not be loaded. Note that unlike url above, this URL may contain a fragment.
+	UnreachableURL string `json:"unreachableUrl,omitempty"`
+	// Indicates whether this frame was tagged as an ad and why.
+	AdFrameStatus *PageAdFrameStatus `json:"adFrameStatus,omitempty"`
+	// Indicates whether the main document is a secure context and explains why that is the case.
+	SecureContextType PageSecureContextType `json:"secureContextType"`
+	// Indicates whether this is a cross origin isolated context.
+	CrossOriginIsolatedContextType PageCrossOriginIsolatedContextType `json:"crossOriginIsolatedContextType"`
+	// Indicated which gated APIs / features are available.
+	GatedAPIFeatures []PageGatedAPIFeatures `json:"gatedAPIFeatures"`
+}
+
+// PageFrameResource: Information about the Resource on the page.
+type PageFrameResource struct {
+	// Resource URL.
+	URL string `json:"url"`
+	// Type of this resource.
+	Type NetworkResourceType `json:"type"`
+	// Resource mimeType as determined by the browser.
+	MimeType string `json:"mimeType"`
+	// last-modified timestamp as reported by server.
+	LastModified NetworkTimeSinceEpoch `json:"lastModified,omitempty"`
+	// Resource content size.
+	ContentSize float64 `json:"contentSize,omitempty"`
+	// True if the resource failed to load.
+	Failed bool `json:"failed,omitempty"`
+	// True if the resource was canceled during loading.
+	Canceled bool `json:"canceled,omitempty"`
+}
+
+// PageFrameResourceTree: Information about the Frame hierarchy along with their cached resources.
+type PageFrameResourceTree struct {
+	// Frame information for this tree item.
+	Frame PageFrame `json:"frame"`
+	// Child frames.
+	ChildFrames []PageFrameResourceTree `json:"childFrames,omitempty"`
+	// Information about frame resources.
+	Resources []PageFrameResource `json:"resources"`
+}
+
+// PageFrameTree: Information about the Frame hierarchy.
+type PageFrameTree struct {
+	// Frame information for this tree item.
+	Frame PageFrame `json:"frame"`
+	// Child frames.
+	ChildFrames []PageFrameTree `json:"childFrames,omitempty"`
+}
+
+// PageScriptIdentifier: Unique script identifier.
+type PageScriptIdentifier = string
+
+// PageTransitionType: Transition type.
+type PageTransitionType = string
+
+// PageNavigationEntry: Navigation history entry.
+type PageNavigationEntry struct {
+	// Unique id of the navigation history entry.
+	ID int `json:"id"`
+	// URL of the navigation history entry.
+	URL string `json:"url"`
+	// URL that the user typed in the url bar.
+	UserTypedURL string `json:"userTypedURL"`
+	// Title of the navigation history entry.
+	Title string `json:"title"`
+	// Transition type.
+	TransitionType PageTransitionType `json:"transitionType"`
+}
+
+// PageScreencastFrameMetadata: Screencast frame metadata.
+type PageScreencastFrameMetadata struct {
+	// Top offset in DIP.
+	OffsetTop float64 `json:"offsetTop"`
+	// Page scale factor.
+	PageScaleFactor float64 `json:"pageScaleFactor"`
+	// Device screen width in DIP.
+	DeviceWidth float64 `json:"deviceWidth"`
+	// Device screen height in DIP.
+	DeviceHeight float64 `json:"deviceHeight"`
+	// Position of horizontal scroll in CSS pixels.
+	ScrollOffsetX float64 `json:"scrollOffsetX"`
+	// Position of vertical scroll in CSS pixels.
+	ScrollOffsetY float64 `json:"scrollOffsetY"`
+	// Frame swap timestamp.
+	Timestamp NetworkTimeSinceEpoch `json:"timestamp,omitempty"`
+}
+
+// PageDialogType: Javascript dialog type.
+type PageDialogType = string
+
+// PageAppManifestError: Error while paring app manifest.
+type PageAppManifestError struct {
+	// Error message.
+	Message string `json:"message"`
+	// If critical, this is a non-recoverable parse error.
+	Critical int `json:"critical"`
+	// Error line.
+	Line int `json:"line"`
+	// Error column.
+	Column int `json:"column"`
+}
+
+// PageAppManifestParsedProperties: Parsed app manifest properties.
+type PageAppManifestParsedProperties struct {
+	// Computed scope value
+	Scope string `json:"scope"`
+}
+
+// PageLayoutViewport: Layout viewport position and dimensions.
+type PageLayoutViewport struct {
+	// Horizontal offset relative to the document (CSS pixels).
+	PageX int `json:"pageX"`
+	// Vertical offset relative to the document (CSS pixels).
+	PageY int `json:"pageY"`
+	// Width (CSS pixels), excludes scrollbar if present.
+	ClientWidth int `json:"clientWidth"`
+	// Height (CSS pixels), excludes scrollbar if present.
+	ClientHeight int `json:"clientHeight"`
+}
+
+// PageVisualViewport: Visual viewport position, dimensions, and scale.
+type PageVisualViewport struct {
+	// Horizontal offset relative to the layout viewport (CSS pixels).
+	OffsetX float64 `json:"offsetX"`
+	// Vertical offset relative to the layout viewport (CSS pixels).
+	OffsetY float64 `json:"offsetY"`
+	// Horizontal offset relative to the document (CSS pixels).
+	PageX float64 `json:"pageX"`
+	// Vertical offset relative to the document (CSS pixels).
+	PageY float64 `json:"pageY"`
+	// Width (CSS pixels), excludes scrollbar if present.
+	ClientWidth float64 `json:"clientWidth"`
+	// Height (CSS pixels), excludes scrollbar if present.
+	ClientHeight float64 `json:"clientHeight"`
+	// Scale relative to the ideal viewport (size at width=device-width).
+	Scale float64 `json:"scale"`
+	// Page zoom factor (CSS to device independent pixels ratio).
+	Zoom float64 `json:"zoom,omitempty"`
+}
+
+// PageViewport: Viewport for capturing screenshot.
+type PageViewport struct {
+	// X offset in device independent pixels (dip).
+	X float64 `json:"x"`
+	// Y offset in device independent pixels (dip).
+	Y float64 `json:"y"`
+	// Rectangle width in device independent pixels (dip).
+	Width float64 `json:"width"`
+	// Rectangle height in device independent pixels (dip).
+	Height float64 `json:"height"`
+	// Page scale factor.
+	Scale float64 `json:"scale"`
+}
+
+// PageFontFamilies: Generic font families collection.
+type PageFontFamilies struct {
+	// The standard font-family.
+	Standard string `json:"standard,omitempty"`
+	// The fixed font-family.
+	Fixed string `json:"fixed,omitempty"`
+	// The serif font-family.
+	Serif string `json:"serif,omitempty"`
+	// The sansSerif font-family.
+	SansSerif string `json:"sansSerif,omitempty"`
+	// The cursive font-family.
+	Cursive string `json:"cursive,omitempty"`
+	// The fantasy font-family.
+	Fantasy string `json:"fantasy,omitempty"`
+	// The math font-family.
+	Math string `json:"math,omitempty"`
+}
+
+// PageScriptFontFamilies: Font families collection for a script.
+type PageScriptFontFamilies struct {
+	// Name of the script which these font families are defined for.
+	Script string `json:"script"`
+	// Generic font families collection for the script.
+	FontFamilies PageFontFamilies `json:"fontFamilies"`
+}
+
+// PageFontSizes: Default font sizes.
+type PageFontSizes struct {
+	// Default standard font size.
+	Standard int `json:"standard,omitempty"`
+	// Default fixed font size.
+	Fixed int `json:"fixed,omitempty"`
+}
+
+type PageClientNavigationReason = string
+
+type PageClientNavigationDisposition = string
+
+type PageInstallabilityErrorArgument struct {
+	// Argument name (e.g. name:'minimum-icon-size-in-pixels').
+	Name string `json:"name"`
+	// Argument value (e.g. value:'64').
+	Value string `json:"value"`
+}
+
+// PageInstallabilityError: The installability error
+type PageInstallabilityError struct {
+	// The error id (e.g. 'manifest-missing-suitable-icon').
+	ErrorID string `json:"errorId"`
+	// The list of error arguments (e.g. {name:'minimum-icon-size-in-pixels', value:'64'}).
+	ErrorArguments []PageInstallabilityErrorArgument `json:"errorArguments"`
+}
+
+// PageReferrerPolicy: The referring-policy used for the navigation.
+type PageReferrerPolicy = string
+
+// PageCompilationCacheParams: Per-script compilation cache parameters for `Page.produceCompilationCache`
+type PageCompilationCacheParams struct {
+	// The URL of the script to produce a compilation cache entry for.
+	URL string `json:"url"`
+	// A hint to the backend whether eager compilation is recommended. (the actual compilation mode used is upon backend discretion).
+	Eager bool `json:"eager,omitempty"`
+}
+
+type PageFileFilter struct {
+	Name    string   `json:"name,omitempty"`
+	Accepts []string `json:"accepts,omitempty"`
+}
+
+type PageFileHandler struct {
+	Action string              `json:"action"`
+	Name   string              `json:"name"`
+	Icons  []PageImageResource `json:"icons,omitempty"`
+	// Mimic a map, name is the key, accepts is the value.
+	Accepts []PageFileFilter `json:"accepts,omitempty"`
+	// Won't repeat the enums, using string for easy comparison. Same as the other enums below.
+	LaunchType string `json:"launchType"`
+}
+
+// PageImageResource: The image definition used in both icon and screenshot.
+type PageImageResource struct {
+	// The src field in the definition, but changing to url in favor of consistency.
+	URL   string `json:"url"`
+	Sizes string `json:"sizes,omitempty"`
+	Type  string `json:"type,omitempty"`
+}
+
+type PageLaunchHandler struct {
+	ClientMode string `json:"clientMode"`
+}
+
+type PageProtocolHandler struct {
+	Protocol string `json:"protocol"`
+	URL      string `json:"url"`
+}
+
+type PageRelatedApplication struct {
+	ID  string `json:"id,omitempty"`
+	URL string `json:"url"`
+}
+
+type PageScopeExtension struct {
+	// Instead of using tuple, this field always returns the serialized string for easy understanding and comparison.
+	Origin            string `json:"origin"`
+	HasOriginWildcard bool   `json:"hasOriginWildcard"`
+}
+
+type PageScreenshot struct {
+	Image      PageImageResource `json:"image"`
+	FormFactor string            `json:"formFactor"`
+	Label      string            `json:"label,omitempty"`
+}
+
+type PageShareTarget struct {
+	Action  string `json:"action"`
+	Method  string `json:"method"`
+	Enctype string `json:"enctype"`
+	// Embed the ShareTargetParams
+	Title string           `json:"title,omitempty"`
+	Text  string           `json:"text,omitempty"`
+	URL   string           `json:"url,omitempty"`
+	Files []PageFileFilter `json:"files,omitempty"`
+}
+
+type PageShortcut struct {
+	Name string `json:"name"`
+	URL  string `json:"url"`
+}
+
+type PageWebAppManifest struct {
+	BackgroundColor string `json:"backgroundColor,omitempty"`
+	// The extra description provided by the manifest.
+	Description string `json:"description,omitempty"`
+	Dir         string `json:"dir,omitempty"`
+	Display     string `json:"display,omitempty"`
+	// The overrided display mode controlled by the user.
+	DisplayOverrides []string `json:"displayOverrides,omitempty"`
+	// The handlers to open files.
+	FileHandlers []PageFileHandler   `json:"fileHandlers,omitempty"`
+	Icons        []PageImageResource `json:"icons,omitempty"`
+	ID           string              `json:"id,omitempty"`
+	Lang         string              `json:"lang,omitempty"`
+	// TODO(crbug.com/1231886): This field is non-standard and part of a Chrome experiment. See: https://github.com/WICG/web-app-launch/blob/main/launch_handler.md
+	LaunchHandler             *PageLaunchHandler `json:"launchHandler,omitempty"`
+	Name                      string             `json:"name,omitempty"`
+	Orientation               string             `json:"orientation,omitempty"`
+	PreferRelatedApplications bool               `json:"preferRelatedApplications,omitempty"`
+	// The handlers to open protocols.
+	ProtocolHandlers    []PageProtocolHandler    `json:"protocolHandlers,omitempty"`
+	RelatedApplications []PageRelatedApplication `json:"relatedApplications,omitempty"`
+	Scope               string                   `json:"scope,omitempty"`
+	// Non-standard, see https://github.com/WICG/manifest-incubations/blob/gh-pages/scope_extensions-explainer.md
+	ScopeExtensions []PageScopeExtension `json:"scopeExtensions,omitempty"`
+	// The screenshots used by chromium.
+	Screenshots []PageScreenshot `json:"screenshots,omitempty"`
+	ShareTarget *PageShareTarget `json:"shareTarget,omitempty"`
+	ShortName   string           `json:"shortName,omitempty"`
+	Shortcuts   []PageShortcut   `json:"shortcuts,omitempty"`
+	StartURL    string           `json:"startUrl,omitempty"`
+	ThemeColor  string           `json:"themeColor,omitempty"`
+}
+
+// PageNavigationType: The type of a frameNavigated event.
+type PageNavigationType = string
+
+// PageBackForwardCacheNotRestoredReason: List of not restored reasons for back-forward cache.
+type PageBackForwardCacheNotRestoredReason = string
+
+// PageBackForwardCacheNotRestoredReasonType: Types of not restored reasons for back-forward cache.
+type PageBackForwardCacheNotRestoredReasonType = string
+
+type PageBackForwardCacheBlockingDetails struct {
+	// Url of the file where blockage happened. Optional because of tests.
+	URL string `json:"url,omitempty"`
+	// Function name where blockage happened. Optional because of anonymous functions and tests.
+	Function string `json:"function,omitempty"`
+	// Line number in the script (0-based).
+	LineNumber int `json:"lineNumber"`
+	// Column number in the script (0-based).
+	ColumnNumber int `json:"columnNumber"`
+}
+
+type PageBackForwardCacheNotRestoredExplanation struct {
+	// Type of the reason
+	Type PageBackForwardCacheNotRestoredReasonType `json:"type"`
+	// Not restored reason
+	Reason PageBackForwardCacheNotRestoredReason `json:"reason"`
+	// Context associated with the reason. The meaning of this context is dependent on the reason: - EmbedderExtensionSentMessageToCachedFrame: the extension ID.
+	Context string                                `json:"context,omitempty"`
+	Details []PageBackForwardCacheBlockingDetails `json:"details,omitempty"`
+}
+
+type PageBackForwardCacheNotRestoredExplanationTree struct {
+	// URL of each frame
+	URL string `json:"url"`
+	// Not restored reasons of each frame
+	Explanations []PageBackForwardCacheNotRestoredExplanation `json:"explanations"`
+	// Array of children frame
+	Children []PageBackForwardCacheNotRestoredExplanationTree `json:"children"`
+}
+
+// PageAddScriptToEvaluateOnLoadParams are the parameters of Page.addScriptToEvaluateOnLoad. Deprecated, please use addScriptToEvaluateOnNewDocument instead.
+type PageAddScriptToEvaluateOnLoadParams struct {
+	ScriptSource string `json:"scriptSource"`
+}
+
+// PageAddScriptToEvaluateOnLoadReturns is the result of Page.addScriptToEvaluateOnLoad.
+type PageAddScriptToEvaluateOnLoadReturns struct {
+	// Identifier of the added script.
+	Identifier PageScriptIdentifier `json:"identifier"`
+}
+
+// PageAddScriptToEvaluateOnNewDocumentParams are the parameters of Page.addScriptToEvaluateOnNewDocument. Evaluates given script in every frame upon creation (before loading frame's scripts).
+type PageAddScriptToEvaluateOnNewDocumentParams struct {
+	Source string `json:"source"`
+	// If specified, creates an isolated world with the given name and evaluates given script in it. This world name will be used as the ExecutionContextDescription::name when the corresponding event is emitted.
+	WorldName string `json:"worldName,omitempty"`
+	// Specifies whether command line API should be available to the script, defaults to false.
+	IncludeCommandLineAPI bool `json:"includeCommandLineAPI,omitempty"`
+	// If true, runs the script immediately on existing execution contexts or worlds. Default: false.
+	RunImmediately bool `json:"runImmediately,omitempty"`
+}
+
+// PageAddScriptToEvaluateOnNewDocumentReturns is the result of Page.addScriptToEvaluateOnNewDocument.
+type PageAddScriptToEvaluateOnNewDocumentReturns struct {
+	// Identifier of the added script.
+	Identifier PageScriptIdentifier `json:"identifier"`
+}
+
+// PageCaptureScreenshotParams are the parameters of Page.captureScreenshot. Capture page screenshot.
+type PageCaptureScreenshotParams struct {
+	// Image compression format (defaults to png).
+	Format string `json:"format,omitempty"`
+	// Compression quality from range [0..100] (jpeg only).
+	Quality int `json:"quality,omitempty"`
+	// Capture the screenshot of a given region only.
+	Clip *PageViewport `json:"clip,omitempty"`
+	// Capture the screenshot from the surface, rather than the view. Defaults to true.
+	FromSurface bool `json:"fromSurface,omitempty"`
+	// Capture the screenshot beyond the viewport. Defaults to false.
+	CaptureBeyondViewport bool `json:"captureBeyondViewport,omitempty"`
+	// Optimize image encoding for speed, not for resulting size (defaults to false)
+	OptimizeForSpeed bool `json:"optimizeForSpeed,omitempty"`
+}
+
+// PageCaptureScreenshotReturns is the result of Page.captureScreenshot.
+type PageCaptureScreenshotReturns struct {
+	// Base64-encoded image data. (Encoded as a base64 string when passed over JSON)
+	Data string `json:"data"`
+}
+
+// PageCaptureSnapshotParams are the parameters of Page.captureSnapshot. Returns a snapshot of the page as a string. For MHTML format, the serialization includes iframes, shadow DOM, external resources, and element-inline styles.
+type PageCaptureSnapshotParams struct {
+	// Format (defaults to mhtml).
+	Format string `json:"format,omitempty"`
+}
+
+// PageCaptureSnapshotReturns is the result of Page.captureSnapshot.
+type PageCaptureSnapshotReturns struct {
+	// Serialized page data.
+	Data string `json:"data"`
+}
+
+// PageCreateIsolatedWorldParams are the parameters of Page.createIsolatedWorld. Creates an isolated world for the given frame.
+type PageCreateIsolatedWorldParams struct {
+	// Id of the frame in which the isolated world should be created.
+	FrameID PageFrameID `json:"frameId"`
+	// An optional name which is reported in the Execution Context.
+	WorldName string `json:"worldName,omitempty"`
+	// Whether or not universal access should be granted to the isolated world. This is a powerful option, use with caution.
+	GrantUniveralAccess bool `json:"grantUniveralAccess,omitempty"`
+}
+
+// PageCreateIsolatedWorldReturns is the result of Page.createIsolatedWorld.
+type PageCreateIsolatedWorldReturns struct {
+	// Execution context of the isolated world.
+	ExecutionContextID RuntimeExecutionContextID `json:"executionContextId"`
+}
+
+// PageDeleteCookieParams are the parameters of Page.deleteCookie. Deletes browser cookie with given name, domain and path.
+type PageDeleteCookieParams struct {
+	// Name of the cookie to remove.
+	CookieName string `json:"cookieName"`
+	// URL to match cooke domain and path.
+	URL string `json:"url"`
+}
+
+// PageEnableParams are the parameters of Page.enable. Enables page domain notifications.
+type PageEnableParams struct {
+	// If true, the `Page.fileChooserOpened` event will be emitted regardless of the state set by `Page.setInterceptFileChooserDialog` command (default: false).
+	EnableFileChooserOpenedEvent bool `json:"enableFileChooserOpenedEvent,omitempty"`
+}
+
+// PageGetAppManifestParams are the parameters of Page.getAppManifest. Gets the processed manifest for this current document. This API always waits for the manifest to be loaded. If manifestId is provided, and it does not match the manifest of the current document, this API errors out. If there is not a loaded page, this API errors out immediately.
+type PageGetAppManifestParams struct {
+	ManifestID string `json:"manifestId,omitempty"`
+}
+
+// PageGetAppManifestReturns is the result of Page.getAppManifest.
+type PageGetAppManifestReturns struct {
+	// Manifest location.
+	URL    string                 `json:"url"`
+	Errors []PageAppManifestError `json:"errors"`
+	// Manifest content.
+	Data string `json:"data,omitempty"`
+	// Parsed manifest properties. Deprecated, use manifest instead.
+	Parsed   *PageAppManifestParsedProperties `json:"parsed,omitempty"`
+	Manifest PageWebAppManifest               `json:"manifest"`
+}
+
+// PageGetInstallabilityErrorsReturns is the result of Page.getInstallabilityErrors.
+type PageGetInstallabilityErrorsReturns struct {
+	InstallabilityErrors []PageInstallabilityError `json:"installabilityErrors"`
+}
+
+// PageGetManifestIconsReturns is the result of Page.getManifestIcons.
+type PageGetManifestIconsReturns struct {
+	PrimaryIcon string `json:"primaryIcon,omitempty"`
+}
+
+// PageGetAppIDReturns is the result of Page.getAppId.
+type PageGetAppIDReturns struct {
+	// App id, either from manifest's id attribute or computed from start_url
+	AppID string `json:"appId,omitempty"`
+	// Recommendation for manifest's id attribute to match current id computed from start_url
+	RecommendedID string `json:"recommendedId,omitempty"`
+}
+
+// PageGetAdScriptAncestryParams are the parameters of Page.getAdScriptAncestry.
+type PageGetAdScriptAncestryParams struct {
+	FrameID PageFrameID `json:"frameId"`
+}
+
+// PageGetAdScriptAncestryReturns is the result of Page.getAdScriptAncestry.
+type PageGetAdScriptAncestryReturns struct {
+	// The ancestry chain of ad script identifiers leading to this frame's creation, along with the root script's filterlist rule. The ancestry chain is ordered from the most immediate script (in the frame creation stack) to more distant ancestors (that created the immediately preceding script). Only sent if frame is labelled as an ad and ids are available.
+	AdScriptAncestry *PageAdScriptAncestry `json:"adScriptAncestry,omitempty"`
+}
+
+// PageGetFrameTreeReturns is the result of Page.getFrameTree.
+type PageGetFrameTreeReturns struct {
+	// Present frame tree structure.
+	FrameTree PageFrameTree `json:"frameTree"`
+}
+
+// PageGetLayoutMetricsReturns is the result of Page.getLayoutMetrics.
+type PageGetLayoutMetricsReturns struct {
+	// Deprecated metrics relating to the layout viewport. Is in device pixels. Use `cssLayoutViewport` instead.
+	LayoutViewport PageLayoutViewport `json:"layoutViewport"`
+	// Deprecated metrics relating to the visual viewport. Is in device pixels. Use `cssVisualViewport` instead.
+	VisualViewport PageVisualViewport `json:"visualViewport"`
+	// Deprecated size of scrollable area. Is in DP. Use `cssContentSize` instead.
+	ContentSize json.RawMessage `json:"contentSize"`
+	// Metrics relating to the layout viewport in CSS pixels.
+	CSSLayoutViewport PageLayoutViewport `json:"cssLayoutViewport"`
+	// Metrics relating to the visual viewport in CSS pixels.
+	CSSVisualViewport PageVisualViewport `json:"cssVisualViewport"`
+	// Size of scrollable area in CSS pixels.
+	CSSContentSize json.RawMessage `json:"cssContentSize"`
+}
+
+// PageGetNavigationHistoryReturns is the result of Page.getNavigationHistory.
+type PageGetNavigationHistoryReturns struct {
+	// Index of the current navigation history entry.
+	CurrentIndex int `json:"currentIndex"`
+	// Array of navigation history entries.
+	Entries []PageNavigationEntry `json:"entries"`
+}
+
+// PageGetResourceContentParams are the parameters of Page.getResourceContent. Returns content of the given resource.
+type PageGetResourceContentParams struct {
+	// Frame id to get resource for.
+	FrameID PageFrameID `json:"frameId"`
+	// URL of the resource to get content for.
+	URL string `json:"url"`
+}
+
+// PageGetResourceContentReturns is the result of Page.getResourceContent.
+type PageGetResourceContentReturns struct {
+	// Resource content.
+	Content string `json:"content"`
+	// True, if content was served as base64.
+	Base64Encoded bool `json:"base64Encoded"`
+}
+
+// PageGetResourceTreeReturns is the result of Page.getResourceTree.
+type PageGetResourceTreeReturns struct {
+	// Present frame / resource tree structure.
+	FrameTree PageFrameResourceTree `json:"frameTree"`
+}
+
+// PageHandleJavaScriptDialogParams are the parameters of Page.handleJavaScriptDialog. Accepts or dismisses a JavaScript initiated dialog (alert, confirm, prompt, or onbeforeunload).
+type PageHandleJavaScriptDialogParams struct {
+	// Whether to accept or dismiss the dialog.
+	Accept bool `json:"accept"`
+	// The text to enter into the dialog prompt before accepting. Used only if this is a prompt dialog.
+	PromptText string `json:"promptText,omitempty"`
+}
+
+// PageNavigateParams are the parameters of Page.navigate. Navigates current page to the given URL.
+type PageNavigateParams struct {
+	// URL to navigate the page to.
+	URL string `json:"url"`
+	// Referrer URL.
+	Referrer string `json:"referrer,omitempty"`
+	// Intended transition type.
+	TransitionType PageTransitionType `json:"transitionType,omitempty"`
+	// Frame id to navigate, if not specified navigates the top frame.
+	FrameID PageFrameID `json:"frameId,omitempty"`
+	// Referrer-policy used for the navigation.
+	ReferrerPolicy PageReferrerPolicy `json:"referrerPolicy,omitempty"`
+}
+
+// PageNavigateReturns is the result of Page.navigate.
+type PageNavigateReturns struct {
+	// Frame id that has navigated (or failed to navigate)
+	FrameID PageFrameID `json:"frameId"`
+	// Loader identifier. This is omitted in case of same-document navigation, as the previously committed loaderId would not change.
+	LoaderID NetworkLoaderID `json:"loaderId,omitempty"`
+	// User friendly error message, present if and only if navigation has failed.
+	ErrorText string `json:"errorText,omitempty"`
+	// Whether the navigation resulted in a download.
+	IsDownload bool `json:"isDownload,omitempty"`
+}
+
+// PageNavigateToHistoryEntryParams are the parameters of Page.navigateToHistoryEntry. Navigates current page to the given history entry.
+type PageNavigateToHistoryEntryParams struct {
+	// Unique id of the entry to navigate to.
+	EntryID int `json:"entryId"`
+}
+
+// PagePrintToPDFParams are the parameters of Page.printToPDF. Print page as PDF.
+type PagePrintToPDFParams struct {
+	// Paper orientation. Defaults to false.
+	Landscape bool `json:"landscape,omitempty"`
+	// Display header and footer. Defaults to false.
+	DisplayHeaderFooter bool `json:"displayHeaderFooter,omitempty"`
+	// Print background graphics. Defaults to false.
+	PrintBackground bool `json:"printBackground,omitempty"`
+	// Scale of the webpage rendering. Defaults to 1.
+	Scale float64 `json:"scale,omitempty"`
+	// Paper width in inches. Defaults to 8.5 inches.
+	PaperWidth float64 `json:"paperWidth,omitempty"`
+	// Paper height in inches. Defaults to 11 inches.
+	PaperHeight float64 `json:"paperHeight,omitempty"`
+	// Top margin in inches. Defaults to 1cm (~0.4 inches).
+	MarginTop float64 `json:"marginTop,omitempty"`
+	// Bottom margin in inches. Defaults to 1cm (~0.4 inches).
+	MarginBottom float64 `json:"marginBottom,omitempty"`
+	// Left margin in inches. Defaults to 1cm (~0.4 inches).
+	MarginLeft float64 `json:"marginLeft,omitempty"`
+	// Right margin in inches. Defaults to 1cm (~0.4 inches).
+	MarginRight float64 `json:"marginRight,omitempty"`
+	// Paper ranges to print, one based, e.g., '1-5, 8, 11-13'. Pages are printed in the document order, not in the order specified, and no more than once. Defaults to empty string, which implies the entire document is printed. The page numbers are quietly capped to actual page count of the document, and ranges beyond the end of the document are ignored. If this results in no pages to print, an error is reported. It is an error to specify a range with start greater than end.
+	PageRanges string `json:"pageRanges,omitempty"`
+	// HTML template for the print header. Should be valid HTML markup with following classes used to inject printing values into them: - `date`: formatted print date - `title`: document title - `url`: document location - `pageNumber`: current page number - `totalPages`: total pages in the document For example, `<span class=title></span>` would generate span containing the title.
+	HeaderTemplate string `json:"headerTemplate,omitempty"`
+	// HTML template for the print footer. Should use the same format as the `headerTemplate`.
+	FooterTemplate string `json:"footerTemplate,omitempty"`
+	// Whether or not to prefer page size as defined by css. Defaults to false, in which case the content will be scaled to fit the paper size.
+	PreferCSSPageSize bool `json:"preferCSSPageSize,omitempty"`
+	// return as stream
+	TransferMode string `json:"transferMode,omitempty"`
+	// Whether or not to generate tagged (accessible) PDF. Defaults to embedder choice.
+	GenerateTaggedPDF bool `json:"generateTaggedPDF,omitempty"`
+	// Whether or not to embed the document outline into the PDF.
+	GenerateDocumentOutline bool `json:"generateDocumentOutline,omitempty"`
+}
+
+// PagePrintToPDFReturns is the result of Page.printToPDF.
+type PagePrintToPDFReturns struct {
+	// Base64-encoded pdf data. Empty if |returnAsStream| is specified. (Encoded as a base64 string when passed over JSON)
+	Data string `json:"data"`
+	// A handle of the stream that holds resulting PDF data.
+	Stream json.RawMessage `json:"stream,omitempty"`
+}
+
+// PageReloadParams are the parameters of Page.reload. Reloads given page optionally ignoring the cache.
+type PageReloadParams struct {
+	// If true, browser cache is ignored (as if the user pressed Shift+refresh).
+	IgnoreCache bool `json:"ignoreCache,omitempty"`
+	// If set, the script will be injected into all frames of the inspected page after reload. Argument will be ignored if reloading dataURL origin.
+	ScriptToEvaluateOnLoad string `json:"scriptToEvaluateOnLoad,omitempty"`
+	// If set, an error will be thrown if the target page's main frame's loader id does not match the provided id. This prevents accidentally reloading an unintended target in case there's a racing navigation.
+	LoaderID NetworkLoaderID `json:"loaderId,omitempty"`
+}
+
+// PageRemoveScriptToEvaluateOnLoadParams are the parameters of Page.removeScriptToEvaluateOnLoad. Deprecated, please use removeScriptToEvaluateOnNewDocument instead.
+type PageRemoveScriptToEvaluateOnLoadParams struct {
+	Identifier PageScriptIdentifier `json:"identifier"`
+}
+
+// PageRemoveScriptToEvaluateOnNewDocumentParams are the parameters of Page.removeScriptToEvaluateOnNewDocument. Removes given script from the list.
+type PageRemoveScriptToEvaluateOnNewDocumentParams struct {
+	Identifier PageScriptIdentifier `json:"identifier"`
+}
+
+// PageScreencastFrameAckParams are the parameters of Page.screencastFrameAck. Acknowledges that a screencast frame has been received by the frontend.
+type PageScreencastFrameAckParams struct {
+	// Frame number.
+	SessionID int `json:"sessionId"`
+}
+
+// PageSearchInResourceParams are the parameters of Page.searchInResource. Searches for given string in resource content.
+type PageSearchInResourceParams struct {
+	// Frame id for resource to search in.
+	FrameID PageFrameID `json:"frameId"`
+	// URL of the resource to search in.
+	URL string `json:"url"`
+	// String to search for.
+	Query string `json:"query"`
+	// If true, search is case sensitive.
+	CaseSensitive bool `json:"caseSensitive,omitempty"`
+	// If true, treats string parameter as regex.
+	IsRegex bool `json:"isRegex,omitempty"`
+}
+
+// PageSearchInResourceReturns is the result of Page.searchInResource.
+type PageSearchInResourceReturns struct {
+	// List of search matches.
+	Result []DebuggerSearchMatch `json:"result"`
+}
+
+// PageSetAdBlockingEnabledParams are the parameters of Page.setAdBlockingEnabled. Enable Chrome's experimental ad filter on all sites.
+type PageSetAdBlockingEnabledParams struct {
+	// Whether to block ads.
+	Enabled bool `json:"enabled"`
+}
+
+// PageSetBypassCSPParams are the parameters of Page.setBypassCSP. Enable page Content Security Policy by-passing.
+type PageSetBypassCSPParams struct {
+	// Whether to bypass page CSP.
+	Enabled bool `json:"enabled"`
+}
+
+// PageGetPermissionsPolicyStateParams are the parameters of Page.getPermissionsPolicyState. Get Permissions Policy state on given frame.
+type PageGetPermissionsPolicyStateParams struct {
+	FrameID PageFrameID `json:"frameId"`
+}
+
+// PageGetPermissionsPolicyStateReturns is the result of Page.getPermissionsPolicyState.
+type PageGetPermissionsPolicyStateReturns struct {
+	States []PagePermissionsPolicyFeatureState `json:"states"`
+}
+
+// PageGetOriginTrialsParams are the parameters of Page.getOriginTrials. Get Origin Trials on given frame.
+type PageGetOriginTrialsParams struct {
+	FrameID PageFrameID `json:"frameId"`
+}
+
+// PageGetOriginTrialsReturns is the result of Page.getOriginTrials.
+type PageGetOriginTrialsReturns struct {
+	OriginTrials []PageOriginTrial `json:"originTrials"`
+}
+
+// PageSetDeviceMetricsOverrideParams are the parameters of Page.setDeviceMetricsOverride. Overrides the values of device screen dimensions (window.screen.width, window.screen.height, window.innerWidth, window.innerHeight, and "device-width"/"device-height"-related CSS media query results).
+type PageSetDeviceMetricsOverrideParams struct {
+	// Overriding width value in pixels (minimum 0, maximum 10000000). 0 disables the override.
+	Width int `json:"width"`
+	// Overriding height value in pixels (minimum 0, maximum 10000000). 0 disables the override.
+	Height int `json:"height"`
+	// Overriding device scale factor value. 0 disables the override.
+	DeviceScaleFactor float64 `json:"deviceScaleFactor"`
+	// Whether to emulate mobile device. This includes viewport meta tag, overlay scrollbars, text autosizing and more.
+	Mobile bool `json:"mobile"`
+	// Scale to apply to resulting view image.
+	Scale float64 `json:"scale,omitempty"`
+	// Overriding screen width value in pixels (minimum 0, maximum 10000000).
+	ScreenWidth int `json:"screenWidth,omitempty"`
+	// Overriding screen height value in pixels (minimum 0, maximum 10000000).
+	ScreenHeight int `json:"screenHeight,omitempty"`
+	// Overriding view X position on screen in pixels (minimum 0, maximum 10000000).
+	PositionX int `json:"positionX,omitempty"`
+	// Overriding view Y position on screen in pixels (minimum 0, maximum 10000000).
+	PositionY int `json:"positionY,omitempty"`
+	// Do not set visible view size, rely upon explicit setVisibleSize call.
+	DontSetVisibleSize bool `json:"dontSetVisibleSize,omitempty"`
+	// Screen orientation override.
+	ScreenOrientation json.RawMessage `json:"screenOrientation,omitempty"`
+	// The viewport dimensions and scale. If not set, the override is cleared.
+	Viewport *PageViewport `json:"viewport,omitempty"`
+}
+
+// PageSetDeviceOrientationOverrideParams are the parameters of Page.setDeviceOrientationOverride. Overrides the Device Orientation.
+type PageSetDeviceOrientationOverrideParams struct {
+	// Mock alpha
+	Alpha float64 `json:"alpha"`
+	// Mock beta
+	Beta float64 `json:"beta"`
+	// Mock gamma
+	Gamma float64 `json:"gamma"`
+}
+
+// PageSetFontFamiliesParams are the parameters of Page.setFontFamilies. Set generic font families.
+type PageSetFontFamiliesParams struct {
+	// Specifies font families to set. If a font family is not specified, it won't be changed.
+	FontFamilies PageFontFamilies `json:"fontFamilies"`
+	// Specifies font families to set for individual scripts.
+	ForScripts []PageScriptFontFamilies `json:"forScripts,omitempty"`
+}
+
+// PageSetFontSizesParams are the parameters of Page.setFontSizes. Set default font sizes.
+type PageSetFontSizesParams struct {
+	// Specifies font sizes to set. If a font size is not specified, it won't be changed.
+	FontSizes PageFontSizes `json:"fontSizes"`
+}
+
+// PageSetDocumentContentParams are the parameters of Page.setDocumentContent. Sets given markup as the document's HTML.
+type PageSetDocumentContentParams struct {
+	// Frame id to set HTML for.
+	FrameID PageFrameID `json:"frameId"`
+	// HTML content to set.
+	HTML string `json:"html"`
+}
+
+// PageSetDownloadBehaviorParams are the parameters of Page.setDownloadBehavior. Set the behavior when downloading a file.
+type PageSetDownloadBehaviorParams struct {
+	// Whether to allow all or deny all download requests, or use default Chrome behavior if available (otherwise deny).
+	Behavior string `json:"behavior"`
+	// The default path to save downloaded files to. This is required if behavior is set to 'allow'
+	DownloadPath string `json:"downloadPath,omitempty"`
+}
+
+// PageSetGeolocationOverrideParams are the parameters of Page.setGeolocationOverride. Overrides the Geolocation Position or Error. Omitting any of the parameters emulates position unavailable.
+type PageSetGeolocationOverrideParams struct {
+	// Mock latitude
+	Latitude float64 `json:"latitude,omitempty"`
+	// Mock longitude
+	Longitude float64 `json:"longitude,omitempty"`
+	// Mock accuracy
+	Accuracy float64 `json:"accuracy,omitempty"`
+}
+
+// PageSetLifecycleEventsEnabledParams are the parameters of Page.setLifecycleEventsEnabled. Controls whether page will emit lifecycle events.
+type PageSetLifecycleEventsEnabledParams struct {
+	// If true, starts emitting lifecycle events.
+	Enabled bool `json:"enabled"`
+}
+
+// PageSetTouchEmulationEnabledParams are the parameters of Page.setTouchEmulationEnabled. Toggles mouse event-based touch event emulation.
+type PageSetTouchEmulationEnabledParams struct {
+	// Whether the touch event emulation should be enabled.
+	Enabled bool `json:"enabled"`
+	// Touch/gesture events configuration. Default: current platform.
+	Configuration string `json:"configuration,omitempty"`
+}
+
+// PageStartScreencastParams are the parameters of Page.startScreencast. Starts sending each frame using the `screencastFrame` event.
+type PageStartScreencastParams struct {
+	// Image compression format.
+	Format string `json:"format,omitempty"`
+	// Compression quality from range [0..100].
+	Quality int `json:"quality,omitempty"`
+	// Maximum screenshot width.
+	MaxWidth int `json:"maxWidth,omitempty"`
+	// Maximum screenshot height.
+	MaxHeight int `json:"maxHeight,omitempty"`
+	// Send every n-th frame.
+	EveryNthFrame int `json:"everyNthFrame,omitempty"`
+}
+
+// PageSetWebLifecycleStateParams are the parameters of Page.setWebLifecycleState. Tries to update the web lifecycle state of the page. It will transition the page to the given state according to: https://github.com/WICG/web-lifecycle/
+type PageSetWebLifecycleStateParams struct {
+	// Target lifecycle state
+	State string `json:"state"`
+}
+
+// PageProduceCompilationCacheParams are the parameters of Page.produceCompilationCache. Requests backend to produce compilation cache for the specified scripts. `scripts` are appended to the list of scripts for which the cache would be produced. The list may be reset during page navigation. When script with a matching URL is encountered, the cache is optionally produced upon backend discretion, based on internal heuristics. See also: `Page.compilationCacheProduced`.
+type PageProduceCompilationCacheParams struct {
+	Scripts []PageCompilationCacheParams `json:"scripts"`
+}
+
+// PageAddCompilationCacheParams are the parameters of Page.addCompilationCache. Seeds compilation cache for given url. Compilation cache does not survive cross-process navigation.
+type PageAddCompilationCacheParams struct {
+	URL string `json:"url"`
+	// Base64-encoded data (Encoded as a base64 string when passed over JSON)
+	Data string `json:"data"`
+}
+
+// PageSetSPCTransactionModeParams are the parameters of Page.setSPCTransactionMode. Sets the Secure Payment Confirmation transaction mode. https://w3c.github.io/secure-payment-confirmation/#sctn-automation-set-spc-transaction-mode
+type PageSetSPCTransactionModeParams struct {
+	Mode string `json:"mode"`
+}
+
+// PageSetRPHRegistrationModeParams are the parameters of Page.setRPHRegistrationMode. Extensions for Custom Handlers API: https://html.spec.whatwg.org/multipage/system-state.html#rph-automation
+type PageSetRPHRegistrationModeParams struct {
+	Mode string `json:"mode"`
+}
+
+// PageGenerateTestReportParams are the parameters of Page.generateTestReport. Generates a report for testing.
+type PageGenerateTestReportParams struct {
+	// Message to be displayed in the report.
+	Message string `json:"message"`
+	// Specifies the endpoint group to deliver the report to.
+	Group string `json:"group,omitempty"`
+}
+
+// PageSetInterceptFileChooserDialogParams are the parameters of Page.setInterceptFileChooserDialog. Intercept file chooser requests and transfer control to protocol clients. When file chooser interception is enabled, native file chooser dialog is not shown. Instead, a protocol event `Page.fileChooserOpened` is emitted.
+type PageSetInterceptFileChooserDialogParams struct {
+	Enabled bool `json:"enabled"`
+	// If true, cancels the dialog by emitting relevant events (if any) in addition to not showing it if the interception is enabled (default: false).
+	Cancel bool `json:"cancel,omitempty"`
+}
+
+// PageSetPrerenderingAllowedParams are the parameters of Page.setPrerenderingAllowed. Enable/disable prerendering manually. This command is a short-term solution for https://crbug.com/1440085. See https://docs.google.com/document/d/12HVmFxYj5Jc-eJr5OmWsa2bqTJsbgGLKI6ZIyx0_wpA for more details. TODO(https://crbug.com/1440085): Remove this once Puppeteer supports tab targets.
+type PageSetPrerenderingAllowedParams struct {
+	IsAllowed bool `json:"isAllowed"`
+}
+
+// PageDOMContentEventFiredEvent is the Page.domContentEventFired event.
+type PageDOMContentEventFiredEvent struct {
+	Timestamp NetworkMonotonicTime `json:"timestamp"`
+}
+
+// PageFileChooserOpenedEvent is the Page.fileChooserOpened event. Emitted only when `page.interceptFileChooser` is enabled.
+type PageFileChooserOpenedEvent struct {
+	// Id of the frame containing input node.
+	FrameID PageFrameID `json:"frameId"`
+	// Input mode.
+	Mode string `json:"mode"`
+	// Input node id. Only present for file choosers opened via an `<input type="file">` element.
+	BackendNodeID json.RawMessage `json:"backendNodeId,omitempty"`
+}
+
+// PageFrameAttachedEvent is the Page.frameAttached event. Fired when frame has been attached to its parent.
+type PageFrameAttachedEvent struct {
+	// Id of the frame that has been attached.
+	FrameID PageFrameID `json:"frameId"`
+	// Parent frame identifier.
+	ParentFrameID PageFrameID `json:"parentFrameId"`
+	// JavaScript stack trace of when frame was attached, only set if frame initiated from script.
+	Stack *RuntimeStackTrace `json:"stack,omitempty"`
+}
+
+// PageFrameClearedScheduledNavigationEvent is the Page.frameClearedScheduledNavigation event. Fired when frame no longer has a scheduled navigation.
+type PageFrameClearedScheduledNavigationEvent struct {
+	// Id of the frame that has cleared its scheduled navigation.
+	FrameID PageFrameID `json:"frameId"`
+}
+
+// PageFrameDetachedEvent is the Page.frameDetached event. Fired when frame has been detached from its parent.
+type PageFrameDetachedEvent struct {
+	// Id of the frame that has been detached.
+	FrameID PageFrameID `json:"frameId"`
+	Reason  string      `json:"reason"`
+}
+
+// PageFrameSubtreeWillBeDetachedEvent is the Page.frameSubtreeWillBeDetached event. Fired before frame subtree is detached. Emitted before any frame of the subtree is actually detached.
+type PageFrameSubtreeWillBeDetachedEvent struct {
+	// Id of the frame that is the root of the subtree that will be detached.
+	FrameID PageFrameID `json:"frameId"`
+}
+
+// PageFrameNavigatedEvent is the Page.frameNavigated event. Fired once navigation of the frame has completed. Frame is now associated with the new loader.
+type PageFrameNavigatedEvent struct {
+	// Frame object.
+	Frame PageFrame          `json:"frame"`
+	Type  PageNavigationType `json:"type"`
+}
+
+// PageDocumentOpenedEvent is the Page.documentOpened event. Fired when opening document to write to.
+type PageDocumentOpenedEvent struct {
+	// Frame object.
+	Frame PageFrame `json:"frame"`
+}
+
+// PageFrameResizedEvent is the Page.frameResized event.
+type PageFrameResizedEvent struct {
+}
+
+// PageFrameStartedNavigatingEvent is the Page.frameStartedNavigating event. Fired when a navigation starts. This event is fired for both renderer-initiated and browser-initiated navigations. For renderer-initiated navigations, the event is fired after `frameRequestedNavigation`. Navigation may still be cancelled after the event is issued. Multiple events can be fired for a single navigation, for example, when a same-document navigation becomes a cross-document navigation (such as in the case of a frameset).
+type PageFrameStartedNavigatingEvent struct {
+	// ID of the frame that is being navigated.
+	FrameID PageFrameID `json:"frameId"`
+	// The URL the navigation started with. The final URL can be different.
+	URL string `json:"url"`
+	// Loader identifier. Even though it is present in case of same-document navigation, the previously committed loaderId would not change unless the navigation changes from a same-document to a cross-document navigation.
+	LoaderID       NetworkLoaderID `json:"loaderId"`
+	NavigationType string          `json:"navigationType"`
+}
+
+// PageFrameRequestedNavigationEvent is the Page.frameRequestedNavigation event. Fired when a renderer-initiated navigation is requested. Navigation may still be cancelled after the event is issued.
+type PageFrameRequestedNavigationEvent struct {
+	// Id of the frame that is being navigated.
+	FrameID PageFrameID `json:"frameId"`
+	// The reason for the navigation.
+	Reason PageClientNavigationReason `json:"reason"`
+	// The destination URL for the requested navigation.
+	URL string `json:"url"`
+	// The disposition for the navigation.
+	Disposition PageClientNavigationDisposition `json:"disposition"`
+}
+
+// PageFrameScheduledNavigationEvent is the Page.frameScheduledNavigation event. Fired when frame schedules a potential navigation.
+type PageFrameScheduledNavigationEvent struct {
+	// Id of the frame that has scheduled a navigation.
+	FrameID PageFrameID `json:"frameId"`
+	// Delay (in seconds) until the navigation is scheduled to begin. The navigation is not guaranteed to start.
+	Delay float64 `json:"delay"`
+	// The reason for the navigation.
+	Reason PageClientNavigationReason `json:"reason"`
+	// The destination URL for the scheduled navigation.
+	URL string `json:"url"`
+}
+
+// PageFrameStartedLoadingEvent is the Page.frameStartedLoading event. Fired when frame has started loading.
+type PageFrameStartedLoadingEvent struct {
+	// Id of the frame that has started loading.
+	FrameID PageFrameID `json:"frameId"`
+}
+
+// PageFrameStoppedLoadingEvent is the Page.frameStoppedLoading event. Fired when frame has stopped loading.
+type PageFrameStoppedLoadingEvent struct {
+	// Id of the frame that has stopped loading.
+	FrameID PageFrameID `json:"frameId"`
+}
+
+// PageDownloadWillBeginEvent is the Page.downloadWillBegin event. Fired when page is about to start a download. Deprecated. Use Browser.downloadWillBegin instead.
+type PageDownloadWillBeginEvent struct {
+	// Id of the frame that caused download to begin.
+	FrameID PageFrameID `json:"frameId"`
+	// Global unique identifier of the download.
+	Guid string `json:"guid"`
+	// URL of the resource being downloaded.
+	URL string `json:"url"`
+	// Suggested file name of the resource (the actual name of the file saved on disk may differ).
+	SuggestedFilename string `json:"suggestedFilename"`
+}
+
+// PageDownloadProgressEvent is the Page.downloadProgress event. Fired when download makes progress. Last call has |done| == true. Deprecated. Use Browser.downloadProgress instead.
+type PageDownloadProgressEvent struct {
+	// Global unique identifier of the download.
+	Guid string `json:"guid"`
+	// Total expected bytes to download.
+	TotalBytes float64 `json:"totalBytes"`
+	// Total bytes received.
+	ReceivedBytes float64 `json:"receivedBytes"`
+	// Download status.
+	State string `json:"state"`
+}
+
+// PageInterstitialHiddenEvent is the Page.interstitialHidden event. Fired when interstitial page was hidden
+type PageInterstitialHiddenEvent struct {
+}
+
+// PageInterstitialShownEvent is the Page.interstitialShown event. Fired when interstitial page was shown
+type PageInterstitialShownEvent struct {
+}
+
+// PageJavascriptDialogClosedEvent is the Page.javascriptDialogClosed event. Fired when a JavaScript initiated dialog (alert, confirm, prompt, or onbeforeunload) has been closed.
+type PageJavascriptDialogClosedEvent struct {
+	// Frame id.
+	FrameID PageFrameID `json:"frameId"`
+	// Whether dialog was confirmed.
+	Result bool `json:"result"`
+	// User input in case of prompt.
+	UserInput string `json:"userInput"`
+}
+
+// PageJavascriptDialogOpeningEvent is the Page.javascriptDialogOpening event. Fired when a JavaScript initiated dialog (alert, confirm, prompt, or onbeforeunload) is about to open.
+type PageJavascriptDialogOpeningEvent struct {
+	// Frame url.
+	URL string `json:"url"`
+	// Frame id.
+	FrameID PageFrameID `json:"frameId"`
+	// Message that will be displayed by the dialog.
+	Message string `json:"message"`
+	// Dialog type.
+	Type PageDialogType `json:"type"`
+	// True iff browser is capable showing or acting on the given dialog. When browser has no dialog handler for given target, calling alert while Page domain is engaged will stall the page execution. Execution can be resumed via calling Page.handleJavaScriptDialog.
+	HasBrowserHandler bool `json:"hasBrowserHandler"`
+	// Default dialog prompt.
+	DefaultPrompt string `json:"defaultPrompt,omitempty"`
+}
+
+// PageLifecycleEventEvent is the Page.lifecycleEvent event. Fired for lifecycle events (navigation, load, paint, etc) in the current target (including local frames).
+type PageLifecycleEventEvent struct {
+	// Id of the frame.
+	FrameID PageFrameID `json:"frameId"`
+	// Loader identifier. Empty string if the request is fetched from worker.
+	LoaderID  NetworkLoaderID      `json:"loaderId"`
+	Name      string               `json:"name"`
+	Timestamp NetworkMonotonicTime `json:"timestamp"`
+}
+
+// PageBackForwardCacheNotUsedEvent is the Page.backForwardCacheNotUsed event. Fired for failed bfcache history navigations if BackForwardCache feature is enabled. Do not assume any ordering with the Page.frameNavigated event. This event is fired only for main-frame history navigation where the document changes (non-same-document navigations), when bfcache navigation fails.
+type PageBackForwardCacheNotUsedEvent struct {
+	// The loader id for the associated navigation.
+	LoaderID NetworkLoaderID `json:"loaderId"`
+	// The frame id of the associated frame.
+	FrameID PageFrameID `json:"frameId"`
+	// Array of reasons why the page could not be cached. This must not be empty.
+	NotRestoredExplanations []PageBackForwardCacheNotRestoredExplanation `json:"notRestoredExplanations"`
+	// Tree structure of reasons why the page could not be cached for each frame.
+	NotRestoredExplanationsTree *PageBackForwardCacheNotRestoredExplanationTree `json:"notRestoredExplanationsTree,omitempty"`
+}
+
+// PageLoadEventFiredEvent is the Page.loadEventFired event.
+type PageLoadEventFiredEvent struct {
+	Timestamp NetworkMonotonicTime `json:"timestamp"`
+}
+
+// PageNavigatedWithinDocumentEvent is the Page.navigatedWithinDocument event. Fired when same-document navigation happens, e.g. due to history API usage or anchor navigation.
+type PageNavigatedWithinDocumentEvent struct {
+	// Id of the frame.
+	FrameID PageFrameID `json:"frameId"`
+	// Frame's new url.
+	URL string `json:"url"`
+	// Navigation type
+	NavigationType string `json:"navigationType"`
+}
+
+// PageScreencastFrameEvent is the Page.screencastFrame event. Compressed image data requested by the `startScreencast`.
+type PageScreencastFrameEvent struct {
+	// Base64-encoded compressed image. (Encoded as a base64 string when passed over JSON)
+	Data string `json:"data"`
+	// Screencast frame metadata.
+	Metadata PageScreencastFrameMetadata `json:"metadata"`
+	// Frame number.
+	SessionID int `json:"sessionId"`
+}
+
+// PageScreencastVisibilityChangedEvent is the Page.screencastVisibilityChanged event. Fired when the page with currently enabled screencast was shown or hidden `.
+type PageScreencastVisibilityChangedEvent struct {
+	// True if the page is visible.
+	Visible bool `json:"visible"`
+}
+
+// PageWindowOpenEvent is the Page.windowOpen event. Fired when a new window is going to be opened, via window.open(), link click, form submission, etc.
+type PageWindowOpenEvent struct {
+	// The URL for the new window.
+	URL string `json:"url"`
+	// Window name.
+	WindowName string `json:"windowName"`
+	// An array of enabled window features.
+	WindowFeatures []string `json:"windowFeatures"`
+	// Whether or not it was triggered by user gesture.
+	UserGesture bool `json:"userGesture"`
+}
+
+// PageCompilationCacheProducedEvent is the Page.compilationCacheProduced event. Issued for every compilation cache generated.
+type PageCompilationCacheProducedEvent struct {
+	URL string `json:"url"`
+	// Base64-encoded data (Encoded as a base64 string when passed over JSON)
+	Data string `json:"data"`
+}
@@ -0,0 +1,607 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package protocol
+
+import (
+	"encoding/json"
+)
+
+// Runtime domain: Runtime domain exposes JavaScript runtime by means of remote evaluation and mirror objects. Evaluation results are returned as mirror object that expose object type, string representation and unique identifier that can be used for further object reference. Original objects are maintained in memory unless they are either explicitly released or are released along with the other objects in their object group.
+
+const (
+	CommandRuntimeAwaitPromise                    = "Runtime.awaitPromise"
+	CommandRuntimeCallFunctionOn                  = "Runtime.callFunctionOn"
+	CommandRuntimeCompileScript                   = "Runtime.compileScript"
+	CommandRuntimeDisable                         = "Runtime.disable"
+	CommandRuntimeDiscardConsoleEntries           = "Runtime.discardConsoleEntries"
+	CommandRuntimeEnable                          = "Runtime.enable"
+	CommandRuntimeEvaluate                        = "Runtime.evaluate"
+	CommandRuntimeGetIsolateID                    = "Runtime.getIsolateId"
+	CommandRuntimeGetHeapUsage                    = "Runtime.getHeapUsage"
+	CommandRuntimeGetProperties                   = "Runtime.getProperties"
+	CommandRuntimeGlobalLexicalScopeNames         = "Runtime.globalLexicalScopeNames"
+	CommandRuntimeQueryObjects                    = "Runtime.queryObjects"
+	CommandRuntimeReleaseObject                   = "Runtime.releaseObject"
+	CommandRuntimeReleaseObjectGroup              = "Runtime.releaseObjectGroup"
+	CommandRuntimeRunIfWaitingForDebugger         = "Runtime.runIfWaitingForDebugger"
+	CommandRuntimeRunScript                       = "Runtime.runScript"
+	CommandRuntimeSetAsyncCallStackDepth          = "Runtime.setAsyncCallStackDepth"
+	CommandRuntimeSetCustomObjectFormatterEnabled = "Runtime.setCustomObjectFormatterEnabled"
+	CommandRuntimeSetMaxCallStackSizeToCapture    = "Runtime.setMaxCallStackSizeToCapture"
+	CommandRuntimeTerminateExecution              = "Runtime.terminateExecution"
+	CommandRuntimeAddBinding                      = "Runtime.addBinding"
+	CommandRuntimeRemoveBinding                   = "Runtime.removeBinding"
+	CommandRuntimeGetExceptionDetails             = "Runtime.getExceptionDetails"
+	EventRuntimeBindingCalled                     = "Runtime.bindingCalled"
+	EventRuntimeConsoleAPICalled                  = "Runtime.consoleAPICalled"
+	EventRuntimeExceptionRevoked                  = "Runtime.exceptionRevoked"
+	EventRuntimeExceptionThrown                   = "Runtime.exceptionThrown"
+	EventRuntimeExecutionContextCreated           = "Runtime.executionContextCreated"
+	EventRuntimeExecutionContextDestroyed         = "Runtime.executionContextDestroyed"
+	EventRuntimeExecutionContextsCleared          = "Runtime.executionContextsCleared"
+	EventRuntimeInspectRequested                  = "Runtime.inspectRequested"
+)
+
+// RuntimeScriptID: Unique script identifier.
+type RuntimeScriptID = string
+
+// RuntimeSerializationOptions: Represents options for serialization. Overrides `generatePreview` and `returnByValue`.
+type RuntimeSerializationOptions struct {
+	Serialization string `json:"serialization"`
+	// Deep serialization depth. Default is full depth. Respected only in `deep` serialization mode.
+	MaxDepth int `json:"maxDepth,omitempty"`
+	// Embedder-specific parameters. For example if connected to V8 in Chrome these control DOM serialization via `maxNodeDepth: integer` and `includeShadowTree: "none" | "open" | "all"`. Values can be only of type string or integer.
+	AdditionalParameters json.RawMessage `json:"additionalParameters,omitempty"`
+}
+
+// RuntimeDeepSerializedValue: Represents deep serialized value.
+type RuntimeDeepSerializedValue struct {
+	Type     string      `json:"type"`
+	Value    interface{} `json:"value,omitempty"`
+	ObjectID string      `json:"objectId,omitempty"`
+	// Set if value reference met more then once during serialization. In such case, value is provided only to one of the serialized values. Unique per value in the scope of one CDP call.
+	WeakLocalObjectReference int `json:"weakLocalObjectReference,omitempty"`
+}
+
+// RuntimeRemoteObjectID: Unique object identifier.
+type RuntimeRemoteObjectID = string
+
+// RuntimeUnserializableValue: Primitive value which cannot be JSON-stringified. Includes values `-0`, `NaN`, `Infinity`, `-Infinity`, and bigint literals.
+type RuntimeUnserializableValue = string
+
+// RuntimeRemoteObject: Mirror object referencing original JavaScript object.
+type RuntimeRemoteObject struct {
+	// Object type.
+	Type string `json:"type"`
+	// Object subtype hint. Specified for `object` type values only. NOTE: If you change anything here, make sure to also update `subtype` in `ObjectPreview` and `PropertyPreview` below.
+	Subtype string `json:"subtype,omitempty"`
+	// Object class (constructor) name. Specified for `object` type values only.
+	ClassName string `json:"className,omitempty"`
+	// Remote object value in case of primitive values or JSON values (if it was requested).
+	Value interface{} `json:"value,omitempty"`
+	// Primitive value which can not be JSON-stringified does not have `value`, but gets this property.
+	UnserializableValue RuntimeUnserializableValue `json:"unserializableValue,omitempty"`
+	// String representation of the object.
+	Description string `json:"description,omitempty"`
+	// Deep serialized value.
+	DeepSerializedValue *RuntimeDeepSerializedValue `json:"deepSerializedValue,omitempty"`
+	// Unique object identifier (for non-primitive values).
+	ObjectID RuntimeRemoteObjectID `json:"objectId,omitempty"`
+	// Preview containing abbreviated property values. Specified for `object` type values only.
+	Preview       *RuntimeObjectPreview `json:"preview,omitempty"`
+	CustomPreview *RuntimeCustomPreview `json:"customPreview,omitempty"`
+}
+
+type RuntimeCustomPreview struct {
+	// The JSON-stringified result of formatter.header(object, config) call. It contains json ML array that represents RemoteObject.
+	Header string `json:"header"`
+	// If formatter returns true as a result of formatter.hasBody call then bodyGetterId will contain RemoteObjectId for the function that returns result of formatter.body(object, config) call. The result value is json ML array.
+	BodyGetterID RuntimeRemoteObjectID `json:"bodyGetterId,omitempty"`
+}
+
+// RuntimeObjectPreview: Object containing abbreviated remote object value.
+type RuntimeObjectPreview struct {
+	// Object type.
+	Type string `json:"type"`
+	// Object subtype hint. Specified for `object` type values only.
+	Subtype string `json:"subtype,omitempty"`
+	// String representation of the object.
+	Description string `json:"description,omitempty"`
+	// True iff some of the properties or entries of the original object did not fit.
+	Overflow bool `json:"overflow"`
+	// List of the properties.
+	Properties []RuntimePropertyPreview `json:"properties"`
+	// List of the entries. Specified for `map` and `set` subtype values only.
+	Entries []RuntimeEntryPreview `json:"entries,omitempty"`
+}
+
+type RuntimePropertyPreview struct {
+	// Property name.
+	Name string `json:"name"`
+	// Object type. Accessor means that the property itself is an accessor property.
+	Type string `json:"type"`
+	// User-friendly property value string.
+	Value string `json:"value,omitempty"`
+	// Nested value preview.
+	ValuePreview *RuntimeObjectPreview `json:"valuePreview,omitempty"`
+	// Object subtype hint. Specified for `object` type values only.
+	Subtype string `json:"subtype,omitempty"`
+}
+
+type RuntimeEntryPreview struct {
+	// Preview of the key. Specified for map-like collection entries.
+	Key *RuntimeObjectPreview `json:"key,omitempty"`
+	// Preview of the value.
+	Value RuntimeObjectPreview `json:"value"`
+}
+
+// RuntimePropertyDescriptor: Object property descriptor.
+type RuntimePropertyDescriptor struct {
+	// Property name or symbol description.
+	Name string `json:"name"`
+	// The value associated with the property.
+	Value *RuntimeRemoteObject `json:"value,omitempty"`
+	// True if the value associated with the property may be changed (data descriptors only).
+	Writable bool `json:"writable,omitempty"`
+	// A function which serves as a getter for the property, or `undefined` if there is no getter (accessor descriptors only).
+	Get *RuntimeRemoteObject `json:"get,omitempty"`
+	// A function which serves as a setter for the property, or `undefined` if there is no setter (accessor descriptors only).
+	Set *RuntimeRemoteObject `json:"set,omitempty"`
+	// True if the type of this property descriptor may be changed and if the property may be deleted from the corresponding object.
+	Configurable bool `json:"configurable"`
+	// True if this property shows up during enumeration of the properties on the corresponding object.
+	Enumerable bool `json:"enumerable"`
+	// True if the result was thrown during the evaluation.
+	WasThrown bool `json:"wasThrown,omitempty"`
+	// True if the property is owned for the object.
+	IsOwn bool `json:"isOwn,omitempty"`
+	// Property symbol object, if the property is of the `symbol` type.
+	Symbol *RuntimeRemoteObject `json:"symbol,omitempty"`
+}
+
+// RuntimeInternalPropertyDescriptor: Object internal property descriptor. This property isn't normally visible in JavaScript code.
+type RuntimeInternalPropertyDescriptor struct {
+	// Conventional property name.
+	Name string `json:"name"`
+	// The value associated with the property.
+	Value *RuntimeRemoteObject `json:"value,omitempty"`
+}
+
+// RuntimePrivatePropertyDescriptor: Object private field descriptor.
+type RuntimePrivatePropertyDescriptor struct {
+	// Private property name.
+	Name string `json:"name"`
+	// The value associated with the private property.
+	Value *RuntimeRemoteObject `json:"value,omitempty"`
+	// A function which serves as a getter for the private property, or `undefined` if there is no getter (accessor descriptors only).
+	Get *RuntimeRemoteObject `json:"get,omitempty"`
+	// A function which serves as a setter for the private property, or `undefined` if there is no setter (accessor descriptors only).
+	Set *RuntimeRemoteObject `json:"set,omitempty"`
+}
+
+// RuntimeCallArgument: Represents function call argument. Either remote object id `objectId`, primitive `value`, unserializable primitive value or neither of (for undefined) them should be specified.
+type RuntimeCallArgument struct {
+	// Primitive value or serializable javascript object.
+	Value interface{} `json:"value,omitempty"`
+	// Primitive value which can not be JSON-stringified.
+	UnserializableValue RuntimeUnserializableValue `json:"unserializableValue,omitempty"`
+	// Remote object handle.
+	ObjectID RuntimeRemoteObjectID `json:"objectId,omitempty"`
+}
+
+// RuntimeExecutionContextID: Id of an execution context.
+type RuntimeExecutionContextID = int
+
+// RuntimeExecutionContextDescription: Description of an isolated world.
+type RuntimeExecutionContextDescription struct {
+	// Unique id of the execution context. It can be used to specify in which execution context script evaluation should be performed.
+	ID RuntimeExecutionContextID `json:"id"`
+	// Execution context origin.
+	Origin string `json:"origin"`
+	// Human readable name describing given context.
+	Name string `json:"name"`
+	// A system-unique execution context identifier. Unlike the id, this is unique across multiple processes, so can be reliably used to identify specific context while backend performs a cross-process navigation.
+	UniqueID string `json:"uniqueId"`
+	// Embedder-specific auxiliary data likely matching {isDefault: boolean, type: 'default'|'isolated'|'worker', frameId: string}
+	AuxData json.RawMessage `json:"auxData,omitempty"`
+}
+
+// RuntimeExceptionDetails: Detailed information about exception (or error) that was thrown during script compilation or execution.
+type RuntimeExceptionDetails struct {
+	// Exception id.
+	ExceptionID int `json:"exceptionId"`
+	// Exception text, which should be used together with exception object when available.
+	Text string `json:"text"`
+	// Line number of the exception location (0-based).
+	LineNumber int `json:"lineNumber"`
+	// Column number of the exception location (0-based).
+	ColumnNumber int `json:"columnNumber"`
+	// Script ID of the exception location.
+	ScriptID RuntimeScriptID `json:"scriptId,omitempty"`
+	// URL of the exception location, to be used when the script was not reported.
+	URL string `json:"url,omitempty"`
+	// JavaScript stack trace if available.
+	StackTrace *RuntimeStackTrace `json:"stackTrace,omitempty"`
+	// Exception object if available.
+	Exception *RuntimeRemoteObject `json:"exception,omitempty"`
+	// Identifier of the context where exception happened.
+	ExecutionContextID RuntimeExecutionContextID `json:"executionContextId,omitempty"`
+	// Dictionary with entries of meta data that the client associated with this exception, such as information about associated network requests, etc.
+	ExceptionMetaData json.RawMessage `json:"exceptionMetaData,omitempty"`
+}
+
+// RuntimeTimestamp: Number of milliseconds since epoch.
+type RuntimeTimestamp = float64
+
+// RuntimeTimeDelta: Number of milliseconds.
+type RuntimeTimeDelta = float64
+
+// RuntimeCallFrame: Stack entry for runtime errors and assertions.
+type RuntimeCallFrame struct {
+	// JavaScript function name.
+	FunctionName string `json:"functionName"`
+	// JavaScript script id.
+	ScriptID RuntimeScriptID `json:"scriptId"`
+	// JavaScript script name or url.
+	URL string `json:"url"`
+	// JavaScript script line number (0-based).
+	LineNumber int `json:"lineNumber"`
+	// JavaScript script column number (0-based).
+	ColumnNumber int `json:"columnNumber"`
+}
+
+// RuntimeStackTrace: Call frames for assertions or error messages.
+type RuntimeStackTrace struct {
+	// String label of this stack trace. For async traces this may be a name of the function that initiated the async call.
+	Description string `json:"description,omitempty"`
+	// JavaScript function name.
+	CallFrames []RuntimeCallFrame `json:"callFrames"`
+	// Asynchronous JavaScript stack trace that preceded this stack, if available.
+	Parent *RuntimeStackTrace `json:"parent,omitempty"`
+	// Asynchronous JavaScript stack trace that preceded this stack, if available.
+	ParentID *RuntimeStackTraceID `json:"parentId,omitempty"`
+}
+
+// RuntimeUniqueDebuggerID: Unique identifier of current debugger.
+type RuntimeUniqueDebuggerID = string
+
+// RuntimeStackTraceID: If `debuggerId` is set stack trace comes from another debugger and can be resolved there. This allows to track cross-debugger calls. See `Runtime.StackTrace` and `Debugger.paused` for usages.
+type RuntimeStackTraceID struct {
+	ID         string                  `json:"id"`
+	DebuggerID RuntimeUniqueDebuggerID `json:"debuggerId,omitempty"`
+}
+
+// RuntimeAwaitPromiseParams are the parameters of Runtime.awaitPromise. Add handler to promise with given promise object id.
+type RuntimeAwaitPromiseParams struct {
+	// Identifier of the promise.
+	PromiseObjectID RuntimeRemoteObjectID `json:"promiseObjectId"`
+	// Whether the result is expected to be a JSON object that should be sent by value.
+	ReturnByValue bool `json:"returnByValue,omitempty"`
+	// Whether preview should be generated for the result.
+	GeneratePreview bool `json:"generatePreview,omitempty"`
+}
+
+// RuntimeAwaitPromiseReturns is the result of Runtime.awaitPromise.
+type RuntimeAwaitPromiseReturns struct {
+	// Promise result. Will contain rejected value if promise was rejected.
+	Result RuntimeRemoteObject `json:"result"`
+	// Exception details if stack strace is available.
+	ExceptionDetails *RuntimeExceptionDetails `json:"exceptionDetails,omitempty"`
+}
+
+// RuntimeCallFunctionOnParams are the parameters of Runtime.callFunctionOn. Calls function with given declaration on the given object. Object group of the result is inherited from the target object.
+type RuntimeCallFunctionOnParams struct {
+	// Declaration of the function to call.
+	FunctionDeclaration string `json:"functionDeclaration"`
+	// Identifier of the object to call function on. Either objectId or executionContextId should be specified.
+	ObjectID RuntimeRemoteObjectID `json:"objectId,omitempty"`
+	// Call arguments. All call arguments must belong to the same JavaScript world as the target object.
+	Arguments []RuntimeCallArgument `json:"arguments,omitempty"`
+	// In silent mode exceptions thrown during evaluation are not reported and do not pause execution. Overrides `setPauseOnException` state.
+	Silent bool `json:"silent,omitempty"`
+	// Whether the result is expected to be a JSON object which should be sent by value. Can be overriden by `serializationOptions`.
+	ReturnByValue bool `json:"returnByValue,omitempty"`
+	// Whether preview should be generated for the result.
+	GeneratePreview bool `json:"generatePreview,omitempty"`
+	// Whether execution should be treated as initiated by user in the UI.
+	UserGesture bool `json:"userGesture,omitempty"`
+	// Whether execution should `await` for resulting value and return once awaited promise is resolved.
+	AwaitPromise bool `json:"awaitPromise,omitempty"`
+	// Specifies execution context which global object will be used to call function on. Either executionContextId or objectId should be specified.
+	ExecutionContextID RuntimeExecutionContextID `json:"executionContextId,omitempty"`
+	// Symbolic group name that can be used to release multiple objects. If objectGroup is not specified and objectId is, objectGroup will be inherited from object.
+	ObjectGroup string `json:"objectGroup,omitempty"`
+	// Whether to throw an exception if side effect cannot be ruled out during evaluation.
+	ThrowOnSideEffect bool `json:"throwOnSideEffect,omitempty"`
+	// An alternative way to specify the execution context to call function on. Compared to contextId that may be reused across processes, this is guaranteed to be system-unique, so it can be used to prevent accidental function call in context different than intended (e.g. as a result of navigation across process boundaries). This is mutually exclusive with `executionContextId`.
+	UniqueContextID string `json:"uniqueContextId,omitempty"`
+	// Specifies the result serialization. If provided, overrides `generatePreview` and `returnByValue`.
+	SerializationOptions *RuntimeSerializationOptions `json:"serializationOptions,omitempty"`
+}
+
+// RuntimeCallFunctionOnReturns is the result of Runtime.callFunctionOn.
+type RuntimeCallFunctionOnReturns struct {
+	// Call result.
+	Result RuntimeRemoteObject `json:"result"`
+	// Exception details.
+	ExceptionDetails *RuntimeExceptionDetails `json:"exceptionDetails,omitempty"`
+}
+
+// RuntimeCompileScriptParams are the parameters of Runtime.compileScript. Compiles expression.
+type RuntimeCompileScriptParams struct {
+	// Expression to compile.
+	Expression string `json:"expression"`
+	// Source url to be set for the script.
+	SourceURL string `json:"sourceURL"`
+	// Specifies whether the compiled script should be persisted.
+	PersistScript bool `json:"persistScript"`
+	// Specifies in which execution context to perform script run. If the parameter is omitted the evaluation will be performed in the context of the inspected page.
+	ExecutionContextID RuntimeExecutionContextID `json:"executionContextId,omitempty"`
+}
+
+// RuntimeCompileScriptReturns is the result of Runtime.compileScript.
+type RuntimeCompileScriptReturns struct {
+	// Id of the script.
+	ScriptID RuntimeScriptID `json:"scriptId,omitempty"`
+	// Exception details.
+	ExceptionDetails *RuntimeExceptionDetails `json:"exceptionDetails,omitempty"`
+}
+
+// RuntimeEvaluateParams are the parameters of Runtime.evaluate. Evaluates expression on global object.
+type RuntimeEvaluateParams struct {
+	// Expression to evaluate.
+	Expression string `json:"expression"`
+	// Symbolic group name that can be used to release multiple objects.
+	ObjectGroup string `json:"objectGroup,omitempty"`
+	// Determines whether Command Line API should be available during the evaluation.
+	IncludeCommandLineAPI bool `json:"includeCommandLineAPI,omitempty"`
+	// In silent mode exceptions thrown during evaluation are not reported and do not pause execution. Overrides `setPauseOnException` state.
+	Silent bool `json:"silent,omitempty"`
+	// Specifies in which execution context to perform evaluation. If the parameter is omitted the evaluation will be performed in the context of the inspected page. This is mutually exclusive with `uniqueContextId`, which offers an alternative way to identify the execution context that is more reliable in a multi-process environment.
+	ContextID RuntimeExecutionContextID `json:"contextId,omitempty"`
+	// Whether the result is expected to be a JSON object that should be sent by value.
+	ReturnByValue bool `json:"returnByValue,omitempty"`
+	// Whether preview should be generated for the result.
+	GeneratePreview bool `json:"generatePreview,omitempty"`
+	// Whether execution should be treated as initiated by user in the UI.
+	UserGesture bool `json:"userGesture,omitempty"`
+	// Whether execution should `await` for resulting value and return once awaited promise is resolved.
+	AwaitPromise bool `json:"awaitPromise,omitempty"`
+	// Whether to throw an exception if side effect cannot be ruled out during evaluation. This implies `disableBreaks` below.
+	ThrowOnSideEffect bool `json:"throwOnSideEffect,omitempty"`
+	// Terminate execution after timing out (number of milliseconds).
+	Timeout RuntimeTimeDelta `json:"timeout,omitempty"`
+	// Disable breakpoints during execution.
+	DisableBreaks bool `json:"disableBreaks,omitempty"`
+	// Setting this flag to true enables `let` re-declaration and top-level `await`. Note that `let` variables can only be re-declared if they originate from `replMode` themselves.
+	ReplMode bool `json:"replMode,omitempty"`
+	// The Content Security Policy (CSP) for the target might block 'unsafe-eval' which includes eval(), Function(), setTimeout() and setInterval() when called with non-callable arguments. This flag bypasses CSP for this evaluation and allows unsafe-eval. Defaults to true.
+	AllowUnsafeEvalBlockedByCSP bool `json:"allowUnsafeEvalBlockedByCSP,omitempty"`
+	// An alternative way to specify the execution context to evaluate in. Compared to contextId that may be reused across processes, this is guaranteed to be system-unique, so it can be used to prevent accidental evaluation of the expression in context different than intended (e.g. as a result of navigation across process boundaries). This is mutually exclusive with `contextId`.
+	UniqueContextID string `json:"uniqueContextId,omitempty"`
+	// Specifies the result serialization. If provided, overrides `generatePreview` and `returnByValue`.
+	SerializationOptions *RuntimeSerializationOptions `json:"serializationOptions,omitempty"`
+}
+
+// RuntimeEvaluateReturns is the result of Runtime.evaluate.
+type RuntimeEvaluateReturns struct {
+	// Evaluation result.
+	Result RuntimeRemoteObject `json:"result"`
+	// Exception details.
+	ExceptionDetails *RuntimeExceptionDetails `json:"exceptionDetails,omitempty"`
+}
+
+// RuntimeGetIsolateIDReturns is the result of Runtime.getIsolateId.
+type RuntimeGetIsolateIDReturns struct {
+	// The isolate id.
+	ID string `json:"id"`
+}
+
+// RuntimeGetHeapUsageReturns is the result of Runtime.getHeapUsage.
+type RuntimeGetHeapUsageReturns struct {
+	// Used JavaScript heap size in bytes.
+	UsedSize float64 `json:"usedSize"`
+	// Allocated JavaScript heap size in bytes.
+	TotalSize float64 `json:"totalSize"`
+	// Used size in bytes in the embedder's garbage-collected heap.
+	EmbedderHeapUsedSize float64 `json:"embedderHeapUsedSize"`
+	// Size in bytes of backing storage for array buffers and external strings.
+	BackingStorageSize float64 `json:"backingStorageSize"`
+}
+
+// RuntimeGetPropertiesParams are the parameters of Runtime.getProperties. Returns properties of a given object. Object group of the result is inherited from the target object.
+type RuntimeGetPropertiesParams struct {
+	// Identifier of the object to return properties for.
+	ObjectID RuntimeRemoteObjectID `json:"objectId"`
+	// If true, returns properties belonging only to the element itself, not to its prototype chain.
+	OwnProperties bool `json:"ownProperties,omitempty"`
+	// If true, returns accessor properties (with getter/setter) only; internal properties are not returned either.
+	AccessorPropertiesOnly bool `json:"accessorPropertiesOnly,omitempty"`
+	// Whether preview should be generated for the results.
+	GeneratePreview bool `json:"generatePreview,omitempty"`
+	// If true, returns non-indexed properties only.
+	NonIndexedPropertiesOnly bool `json:"nonIndexedPropertiesOnly,omitempty"`
+}
+
+// RuntimeGetPropertiesReturns is the result of Runtime.getProperties.
+type RuntimeGetPropertiesReturns struct {
+	// Object properties.
+	Result []RuntimePropertyDescriptor `json:"result"`
+	// Internal object properties (only of the element itself).
+	InternalProperties []RuntimeInternalPropertyDescriptor `json:"internalProperties,omitempty"`
+	// Object private properties.
+	PrivateProperties []RuntimePrivatePropertyDescriptor `json:"privateProperties,omitempty"`
+	// Exception details.
+	ExceptionDetails *RuntimeExceptionDetails `json:"exceptionDetails,omitempty"`
+}
+
+// RuntimeGlobalLexicalScopeNamesParams are the parameters of Runtime.globalLexicalScopeNames. Returns all let, const and class variables from global scope.
+type RuntimeGlobalLexicalScopeNamesParams struct {
+	// Specifies in which execution context to lookup global scope variables.
+	ExecutionContextID RuntimeExecutionContextID `json:"executionContextId,omitempty"`
+}
+
+// RuntimeGlobalLexicalScopeNamesReturns is the result of Runtime.globalLexicalScopeNames.
+type RuntimeGlobalLexicalScopeNamesReturns struct {
+	Names []string `json:"names"`
+}
+
+// RuntimeQueryObjectsParams are the parameters of Runtime.queryObjects.
+type RuntimeQueryObjectsParams struct {
+	// Identifier of the prototype to return objects for.
+	PrototypeObjectID RuntimeRemoteObjectID `json:"prototypeObjectId"`
+	// Symbolic group name that can be used to release the results.
+	ObjectGroup string `json:"objectGroup,omitempty"`
+}
+
+// RuntimeQueryObjectsReturns is the result of Runtime.queryObjects.
+type RuntimeQueryObjectsReturns struct {
+	// Array with objects.
+	Objects RuntimeRemoteObject `json:"objects"`
+}
+
+// RuntimeReleaseObjectParams are the parameters of Runtime.releaseObject. Releases remote object with given id.
+type RuntimeReleaseObjectParams struct {
+	// Identifier of the object to release.
+	ObjectID RuntimeRemoteObjectID `json:"objectId"`
+}
+
+// RuntimeReleaseObjectGroupParams are the parameters of Runtime.releaseObjectGroup. Releases all remote objects that belong to a given group.
+type RuntimeReleaseObjectGroupParams struct {
+	// Symbolic object group name.
+	ObjectGroup string `json:"objectGroup"`
+}
+
+// RuntimeRunScriptParams are the parameters of Runtime.runScript. Runs script with given id in a given context.
+type RuntimeRunScriptParams struct {
+	// Id of the script to run.
+	ScriptID RuntimeScriptID `json:"scriptId"`
+	// Specifies in which execution context to perform script run. If the parameter is omitted the evaluation will be performed in the context of the inspected page.
+	ExecutionContextID RuntimeExecutionContextID `json:"executionContextId,omitempty"`
+	// Symbolic group name that can be used to release multiple objects.
+	ObjectGroup string `json:"objectGroup,omitempty"`
+	// In silent mode exceptions thrown during evaluation are not reported and do not pause execution. Overrides `setPauseOnException` state.
+	Silent bool `json:"silent,omitempty"`
+	// Determines whether Command Line API should be available during the evaluation.
+	IncludeCommandLineAPI bool `json:"includeCommandLineAPI,omitempty"`
+	// Whether the result is expected to be a JSON object which should be sent by value.
+	ReturnByValue bool `json:"returnByValue,omitempty"`
+	// Whether preview should be generated for the result.
+	GeneratePreview bool `json:"generatePreview,omitempty"`
+	// Whether execution should `await` for resulting value and return once awaited promise is resolved.
+	AwaitPromise bool `json:"awaitPromise,omitempty"`
+}
+
+// RuntimeRunScriptReturns is the result of Runtime.runScript.
+type RuntimeRunScriptReturns struct {
+	// Run result.
+	Result RuntimeRemoteObject `json:"result"`
+	// Exception details.
+	ExceptionDetails *RuntimeExceptionDetails `json:"exceptionDetails,omitempty"`
+}
+
+// RuntimeSetAsyncCallStackDepthParams are the parameters of Runtime.setAsyncCallStackDepth. Enables or disables async call stacks tracking.
+type RuntimeSetAsyncCallStackDepthParams struct {
+	// Maximum depth of async call stacks. Setting to `0` will effectively disable collecting async call stacks (default).
+	MaxDepth int `json:"maxDepth"`
+}
+
+// RuntimeSetCustomObjectFormatterEnabledParams are the parameters of Runtime.setCustomObjectFormatterEnabled.
+type RuntimeSetCustomObjectFormatterEnabledParams struct {
+	Enabled bool `json:"enabled"`
+}
+
+// RuntimeSetMaxCallStackSizeToCaptureParams are the parameters of Runtime.setMaxCallStackSizeToCapture.
+type RuntimeSetMaxCallStackSizeToCaptureParams struct {
+	Size int `json:"size"`
+}
+
+// RuntimeAddBindingParams are the parameters of Runtime.addBinding. If executionContextId is empty, adds binding with the given name on the global objects of all inspected contexts, including those created later, bindings survive reloads. Binding function takes exactly one argument, this argument should be string, in case of any other input, function throws an exception. Each binding function call produces Runtime.bindingCalled notification.
+type RuntimeAddBindingParams struct {
+	Name string `json:"name"`
+	// If specified, the binding would only be exposed to the specified execution context. If omitted and `executionContextName` is not set, the binding is exposed to all execution contexts of the target. This parameter is mutually exclusive with `executionContextName`. Deprecated in favor of `executionContextName` due to an unclear use case and bugs in implementation (crbug.com/1169639). `executionContextId` will be removed in the future.
+	ExecutionContextID RuntimeExecutionContextID `json:"executionContextId,omitempty"`
+	// If specified, the binding is exposed to the executionContext with matching name, even for contexts created after the binding is added. See also `ExecutionContext.name` and `worldName` parameter to `Page.addScriptToEvaluateOnNewDocument`. This parameter is mutually exclusive with `executionContextId`.
+	ExecutionContextName string `json:"executionContextName,omitempty"`
+}
+
+// RuntimeRemoveBindingParams are the parameters of Runtime.removeBinding. This method does not remove binding function from global object but unsubscribes current runtime agent from Runtime.bindingCalled notifications.
+type RuntimeRemoveBindingParams struct {
+	Name string `json:"name"`
+}
+
+// RuntimeGetExceptionDetailsParams are the parameters of Runtime.getExceptionDetails. This method tries to lookup and populate exception details for a JavaScript Error object. Note that the stackTrace portion of the resulting exceptionDetails will only be populated if the Runtime domain was enabled at the time when the Error was thrown.
+type RuntimeGetExceptionDetailsParams struct {
+	// The error object for which to resolve the exception details.
+	ErrorObjectID RuntimeRemoteObjectID `json:"errorObjectId"`
+}
+
+// RuntimeGetExceptionDetailsReturns is the result of Runtime.getExceptionDetails.
+type RuntimeGetExceptionDetailsReturns struct {
+	ExceptionDetails *RuntimeExceptionDetails `json:"exceptionDetails,omitempty"`
+}
+
+// RuntimeBindingCalledEvent is the Runtime.bindingCalled event. Notification is issued every time when binding is called.
+type RuntimeBindingCalledEvent struct {
+	Name    string `json:"name"`
+	Payload string `json:"payload"`
+	// Identifier of the context where the call was made.
+	ExecutionContextID RuntimeExecutionContextID `json:"executionContextId"`
+}
+
+// RuntimeConsoleAPICalledEvent is the Runtime.consoleAPICalled event. Issued when console API was called.
+type RuntimeConsoleAPICalledEvent struct {
+	// Type of the call.
+	Type string `json:"type"`
+	// Call arguments.
+	Args []RuntimeRemoteObject `json:"args"`
+	// Identifier of the context where the call was made.
+	ExecutionContextID RuntimeExecutionContextID `json:"executionContextId"`
+	// Call timestamp.
+	Timestamp RuntimeTimestamp `json:"timestamp"`
+	// Stack trace captured when the call was made. The async stack chain is automatically reported for the following call types: `assert`, `error`, `trace`, `warning`. For other types the async call chain can be retrieved using `Debugger.getStackTrace` and `stackTrace.parentId` field.
+	StackTrace *RuntimeStackTrace `json:"stackTrace,omitempty"`
+	// Console context descriptor for calls on non-default console context (not console.*): 'anonymous#unique-logger-id' for call on unnamed context, 'name#unique-logger-id' for call on named context.
+	Context string `json:"context,omitempty"`
+}
+
+// RuntimeExceptionRevokedEvent is the Runtime.exceptionRevoked event. Issued when unhandled exception was revoked.
+type RuntimeExceptionRevokedEvent struct {
+	// Reason describing why exception was revoked.
+	Reason string `json:"reason"`
+	// The id of revoked exception, as reported in `exceptionThrown`.
+	ExceptionID int `json:"exceptionId"`
+}
+
+// RuntimeExceptionThrownEvent is the Runtime.exceptionThrown event. Issued when exception was thrown and unhandled.
+type RuntimeExceptionThrownEvent struct {
+	// Timestamp of the exception.
+	Timestamp        RuntimeTimestamp        `json:"timestamp"`
+	ExceptionDetails RuntimeExceptionDetails `json:"exceptionDetails"`
+}
+
+// RuntimeExecutionContextCreatedEvent is the Runtime.executionContextCreated event. Issued when new execution context is created.
+type RuntimeExecutionContextCreatedEvent struct {
+	// A newly created execution context.
+	Context RuntimeExecutionContextDescription `json:"context"`
+}
+
+// RuntimeExecutionContextDestroyedEvent is the Runtime.executionContextDestroyed event. Issued when execution context is destroyed.
+type RuntimeExecutionContextDestroyedEvent struct {
+	// Id of the destroyed context
+	ExecutionContextID RuntimeExecutionContextID `json:"executionContextId"`
+	// Unique Id of the destroyed context
+	ExecutionContextUniqueID string `json:"executionContextUniqueId"`
+}
+
+// RuntimeExecutionContextsClearedEvent is the Runtime.executionContextsCleared event. Issued when all executionContexts were cleared in browser
+type RuntimeExecutionContextsClearedEvent struct {
+}
+
+// RuntimeInspectRequestedEvent is the Runtime.inspectRequested event. Issued when object should be inspected (for example, as a result of inspect() command line API call).
+type RuntimeInspectRequestedEvent struct {
+	Object RuntimeRemoteObject `json:"object"`
+	Hints  json.RawMessage     `json:"hints"`
+	// Identifier of the context where the call was made.
+	ExecutionContextID RuntimeExecutionContextID `json:"executionContextId,omitempty"`
+}
@@ -0,0 +1,318 @@
+// Code generated by cdpgen. DO NOT EDIT.
+
+package protocol
+
+// Target domain: Supports additional targets discovery and allows to attach to them.
+
+const (
+	CommandTargetActivateTarget          = "Target.activateTarget"
+	CommandTargetAttachToTarget          = "Target.attachToTarget"
+	CommandTargetAttachToBrowserTarget   = "Target.attachToBrowserTarget"
+	CommandTargetCloseTarget             = "Target.closeTarget"
+	CommandTargetExposeDevToolsProtocol  = "Target.exposeDevToolsProtocol"
+	CommandTargetCreateBrowserContext    = "Target.createBrowserContext"
+	CommandTargetGetBrowserContexts      = "Target.getBrowserContexts"
+	CommandTargetCreateTarget            = "Target.createTarget"
+	CommandTargetDetachFromTarget        = "Target.detachFromTarget"
+	CommandTargetDisposeBrowserContext   = "Target.disposeBrowserContext"
+	CommandTargetGetTargetInfo           = "Target.getTargetInfo"
+	CommandTargetGetTargets              = "Target.getTargets"
+	CommandTargetSendMessageToTarget     = "Target.sendMessageToTarget"
+	CommandTargetSetAutoAttach           = "Target.setAutoAttach"
+	CommandTargetAutoAttachRelated       = "Target.autoAttachRelated"
+	CommandTargetSetDiscoverTargets      = "Target.setDiscoverTargets"
+	CommandTargetSetRemoteLocations      = "Target.setRemoteLocations"
+	CommandTargetOpenDevTools            = "Target.openDevTools"
+	EventTargetAttachedToTarget          = "Target.attachedToTarget"
+	EventTargetDetachedFromTarget        = "Target.detachedFromTarget"
+	EventTargetReceivedMessageFromTarget = "Target.receivedMessageFromTarget"
+	EventTargetTargetCreated             = "Target.targetCreated"
+	EventTargetTargetDestroyed           = "Target.targetDestroyed"
+	EventTargetTargetCrashed             = "Target.targetCrashed"
+	EventTargetTargetInfoChanged         = "Target.targetInfoChanged"
+)
+
+type TargetTargetID = string
+
+// TargetSessionID: Unique identifier of attached debugging session.
+type TargetSessionID = string
+
+type TargetTargetInfo struct {
+	TargetID TargetTargetID `json:"targetId"`
+	// List of types: https://source.chromium.org/chromium/chromium/src/+/main:content/browser/devtools/devtools_agent_host_impl.cc?ss=chromium&q=f:devtools%20-f:out%20%22::kTypeTab%5B%5D%22
+	Type  string `json:"type"`
+	Title string `json:"title"`
+	URL   string `json:"url"`
+	// Whether the target has an attached client.
+	Attached bool `json:"attached"`
+	// Opener target Id
+	OpenerID TargetTargetID `json:"openerId,omitempty"`
+	// Whether the target has access to the originating window.
+	CanAccessOpener bool `json:"canAccessOpener"`
+	// Frame id of originating window (is only set if target has an opener).
+	OpenerFrameID    PageFrameID             `json:"openerFrameId,omitempty"`
+	BrowserContextID BrowserBrowserContextID `json:"browserContextId,omitempty"`
+	// Provides additional details for specific target types. For example, for the type of "page", this may be set to "prerender".
+	Subtype string `json:"subtype,omitempty"`
+}
+
+// TargetFilterEntry: A filter used by target query/discovery/auto-attach operations.
+type TargetFilterEntry struct {
+	// If set, causes exclusion of matching targets from the list.
+	Exclude bool `json:"exclude,omitempty"`
+	// If not present, matches any type.
+	Type string `json:"type,omitempty"`
+}
+
+// TargetTargetFilter: The entries in TargetFilter are matched sequentially against targets and the first entry that matches determines if the target is included or not, depending on the value of `exclude` field in the entry. If filter is not specified, the one assumed is [{type: "browser", exclude: true}, {type: "tab", exclude: true}, {}] (i.e. include everything but `browser` and `tab`).
+type TargetTargetFilter = []TargetFilterEntry
+
+type TargetRemoteLocation struct {
+	Host string `json:"host"`
+	Port int    `json:"port"`
+}
+
+// TargetWindowState: The state of the target window.
+type TargetWindowState = string
+
+// TargetActivateTargetParams are the parameters of Target.activateTarget. Activates (focuses) the target.
+type TargetActivateTargetParams struct {
+	TargetID TargetTargetID `json:"targetId"`
+}
+
+// TargetAttachToTargetParams are the parameters of Target.attachToTarget. Attaches to the target with given id.
+type TargetAttachToTargetParams struct {
+	TargetID TargetTargetID `json:"targetId"`
+	// Enables "flat" access to the session via specifying sessionId attribute in the commands. We plan to make this the default, deprecate non-flattened mode, and eventually retire it. See crbug.com/991325.
+	Flatten bool `json:"flatten,omitempty"`
+}
+
+// TargetAttachToTargetReturns is the result of Target.attachToTarget.
+type TargetAttachToTargetReturns struct {
+	// Id assigned to the session.
+	SessionID TargetSessionID `json:"sessionId"`
+}
+
+// TargetAttachToBrowserTargetReturns is the result of Target.attachToBrowserTarget.
+type TargetAttachToBrowserTargetReturns struct {
+	// Id assigned to the session.
+	SessionID TargetSessionID `json:"sessionId"`
+}
+
+// TargetCloseTargetParams are the parameters of Target.closeTarget. Closes the target. If the target is a page that gets closed too.
+type TargetCloseTargetParams struct {
+	TargetID TargetTargetID `json:"targetId"`
+}
+
+// TargetCloseTargetReturns is the result of Target.closeTarget.
+type TargetCloseTargetReturns struct {
+	// Always set to true. If an error occurs, the response indicates protocol error.
+	Success bool `json:"success"`
+}
+
+// TargetExposeDevToolsProtocolParams are the parameters of Target.exposeDevToolsProtocol. Inject object to the target's main frame that provides a communication channel with browser target. Injected object will be available as `window[bindingName]`. The object has the following API: - `binding.send(json)` - a method to send messages over the remote debugging protocol - `binding.onmessage = json => handleMessage(json)` - a callback that will be called for the protocol notifications and command responses.
+type TargetExposeDevToolsProtocolParams struct {
+	TargetID TargetTargetID `json:"targetId"`
+	// Binding name, 'cdp' if not specified.
+	BindingName string `json:"bindingName,omitempty"`
+	// If true, inherits the current root session's permissions (default: false).
+	InheritPermissions bool `json:"inheritPermissions,omitempty"`
+}
+
+// TargetCreateBrowserContextParams are the parameters of Target.createBrowserContext. Creates a new empty BrowserContext. Similar to an incognito profile but you can have more than one.
+type TargetCreateBrowserContextParams struct {
+	// If specified, disposes this context when debugging session disconnects.
+	DisposeOnDetach bool `json:"disposeOnDetach,omitempty"`
+	// Proxy server, similar to the one passed to --proxy-server
+	ProxyServer string `json:"proxyServer,omitempty"`
+	// Proxy bypass list, similar to the one passed to --proxy-bypass-list
+	ProxyBypassList string `json:"proxyBypassList,omitempty"`
+	// An optional list of origins to grant unlimited cross-origin access to. Parts of the URL other than those constituting origin are ignored.
+	OriginsWithUniversalNetworkAccess []string `json:"originsWithUniversalNetworkAccess,omitempty"`
+}
+
+// TargetCreateBrowserContextReturns is the result of Target.createBrowserContext.
+type TargetCreateBrowserContextReturns struct {
+	// The id of the context created.
+	BrowserContextID BrowserBrowserContextID `json:"browserContextId"`
+}
+
+// TargetGetBrowserContextsReturns is the result of Target.getBrowserContexts.
+type TargetGetBrowserContextsReturns struct {
+	// An array of browser context ids.
+	BrowserContextIds []BrowserBrowserContextID `json:"browserContextIds"`
+}
+
+// TargetCreateTargetParams are the parameters of Target.createTarget. Creates a new page.
+type TargetCreateTargetParams struct {
+	// The initial URL the page will be navigated to. An empty string indicates about:blank.
+	URL string `json:"url"`
+	// Frame left origin in DIP (requires newWindow to be true or headless shell).
+	Left int `json:"left,omitempty"`
+	// Frame top origin in DIP (requires newWindow to be true or headless shell).
+	Top int `json:"top,omitempty"`
+	// Frame width in DIP (requires newWindow to be true or headless shell).
+	Width int `json:"width,omitempty"`
+	// Frame height in DIP (requires newWindow to be true or headless shell).
+	Height int `json:"height,omitempty"`
+	// Frame window state (requires newWindow to be true or headless shell). Default is normal.
+	WindowState TargetWindowState `json:"windowState,omitempty"`
+	// The browser context to create the page in.
+	BrowserContextID BrowserBrowserContextID `json:"browserContextId,omitempty"`
+	// Whether BeginFrames for this target will be controlled via DevTools (headless shell only, not supported on MacOS yet, false by default).
+	EnableBeginFrameControl bool `json:"enableBeginFrameControl,omitempty"`
+	// Whether to create a new Window or Tab (false by default, not supported by headless shell).
+	NewWindow bool `json:"newWindow,omitempty"`
+	// Whether to create the target in background or foreground (false by default, not supported by headless shell).
+	Background bool `json:"background,omitempty"`
+	// Whether to create the target of type "tab".
+	ForTab bool `json:"forTab,omitempty"`
+	// Whether to create a hidden target. The hidden target is observable via protocol, but not present in the tab UI strip. Cannot be created with `forTab: true`, `newWindow: true` or `background: false`. The life-time of the tab is limited to the life-time of the session.
+	Hidden bool `json:"hidden,omitempty"`
+}
+
+// TargetCreateTargetReturns is the result of Target.createTarget.
+type TargetCreateTargetReturns struct {
+	// The id of the page opened.
+	TargetID TargetTargetID `json:"targetId"`
+}
+
+// TargetDetachFromTargetParams are the parameters of Target.detachFromTarget. Detaches session with given id.
+type TargetDetachFromTargetParams struct {
+	// Session to detach.
+	SessionID TargetSessionID `json:"sessionId,omitempty"`
+	// Deprecated.
+	TargetID TargetTargetID `json:"targetId,omitempty"`
+}
+
+// TargetDisposeBrowserContextParams are the parameters of Target.disposeBrowserContext. Deletes a BrowserContext. All the belonging pages will be closed without calling their beforeunload hooks.
+type TargetDisposeBrowserContextParams struct {
+	BrowserContextID BrowserBrowserContextID `json:"browserContextId"`
+}
+
+// TargetGetTargetInfoParams are the parameters of Target.getTargetInfo. Returns information about a target.
+type TargetGetTargetInfoParams struct {
+	TargetID TargetTargetID `json:"targetId,omitempty"`
+}
+
+// TargetGetTargetInfoReturns is the result of Target.getTargetInfo.
+type TargetGetTargetInfoReturns struct {
+	TargetInfo TargetTargetInfo `json:"targetInfo"`
+}
+
+// TargetGetTargetsParams are the parameters of Target.getTargets. Retrieves a list of available targets.
+type TargetGetTargetsParams struct {
+	// Only targets matching filter will be reported. If filter is not specified and target discovery is currently enabled, a filter used for target discovery is used for consistency.
+	Filter TargetTargetFilter `json:"filter,omitempty"`
+}
+
+// TargetGetTargetsReturns is the result of Target.getTargets.
+type TargetGetTargetsReturns struct {
+	// The list of targets.
+	TargetInfos []TargetTargetInfo `json:"targetInfos"`
+}
+
+// TargetSendMessageToTargetParams are the parameters of Target.sendMessageToTarget. Sends protocol message over session with given id. Consider using flat mode instead; see commands attachToTarget, setAutoAttach, and crbug.com/991325.
+type TargetSendMessageToTargetParams struct {
+	Message string `json:"message"`
+	// Identifier of the session.
+	SessionID TargetSessionID `json:"sessionId,omitempty"`
+	// Deprecated.
+	TargetID TargetTargetID `json:"targetId,omitempty"`
+}
+
+// TargetSetAutoAttachParams are the parameters of Target.setAutoAttach. Controls whether to automatically attach to new targets which are considered to be directly related to this one (for example, iframes or workers). When turned on, attaches to all existing related targets as well. When turned off, automatically detaches from all currently attached targets. This also clears all targets added by `autoAttachRelated` from the list of targets to watch for creation of related targets. You might want to call this recursively for auto-attached targets to attach to all available targets.
+type TargetSetAutoAttachParams struct {
+	// Whether to auto-attach to related targets.
+	AutoAttach bool `json:"autoAttach"`
+	// Whether to pause new targets when attaching to them. Use `Runtime.runIfWaitingForDebugger` to run paused targets.
+	WaitForDebuggerOnStart bool `json:"waitForDebuggerOnStart"`
+	// Enables "flat" access to the session via specifying sessionId attribute in the commands. We plan to make this the default, deprecate non-flattened mode, and eventually retire it. See crbug.com/991325.
+	Flatten bool `json:"flatten,omitempty"`
+	// Only targets matching filter will be attached.
+	Filter TargetTargetFilter `json:"filter,omitempty"`
+}
+
+// TargetAutoAttachRelatedParams are the parameters of Target.autoAttachRelated. Adds the specified target to the list of targets that will be monitored for any related target creation (such as child frames, child workers and new versions of service worker) and reported through `attachedToTarget`. The specified target is also auto-attached. This cancels the effect of any previous `setAutoAttach` and is also cancelled by subsequent `setAutoAttach`. Only available at the Browser target.
+type TargetAutoAttachRelatedParams struct {
+	TargetID TargetTargetID `json:"targetId"`
+	// Whether to pause new targets when attaching to them. Use `Runtime.runIfWaitingForDebugger` to run paused targets.
+	WaitForDebuggerOnStart bool `json:"waitForDebuggerOnStart"`
+	// Only targets matching filter will be attached.
+	Filter TargetTargetFilter `json:"filter,omitempty"`
+}
+
+// TargetSetDiscoverTargetsParams are the parameters of Target.setDiscoverTargets. Controls whether to discover available targets and notify via `targetCreated/targetInfoChanged/targetDestroyed` events.
+type TargetSetDiscoverTargetsParams struct {
+	// Whether to discover available targets.
+	Discover bool `json:"discover"`
+	// Only targets matching filter will be attached. If `discover` is false, `filter` must be omitted or empty.
+	Filter TargetTargetFilter `json:"filter,omitempty"`
+}
+
+// TargetSetRemoteLocationsParams are the parameters of Target.setRemoteLocations. Enables target discovery for the specified locations, when `setDiscoverTargets` was set to `true`.
+type TargetSetRemoteLocationsParams struct {
+	// List of remote locations.
+	Locations []TargetRemoteLocation `json:"locations"`
+}
+
+// TargetOpenDevToolsParams are the parameters of Target.openDevTools. Opens a DevTools window for the target.
+type TargetOpenDevToolsParams struct {
+	// This can be the page or tab target ID.
+	TargetID TargetTargetID `json:"targetId"`
+}
+
+// TargetOpenDevToolsReturns is the result of Target.openDevTools.
+type TargetOpenDevToolsReturns struct {
+	// The targetId of DevTools page target.
+	TargetID TargetTargetID `json:"targetId"`
+}
+
+// TargetAttachedToTargetEvent is the Target.attachedToTarget event. Issued when attached to target because of auto-attach or `attachToTarget` command.
+type TargetAttachedToTargetEvent struct {
+	// Identifier assigned to the session used to send/receive messages.
+	SessionID          TargetSessionID  `json:"sessionId"`
+	TargetInfo         TargetTargetInfo `json:"targetInfo"`
+	WaitingForDebugger bool             `json:"waitingForDebugger"`
+}
+
+// TargetDetachedFromTargetEvent is the Target.detachedFromTarget event. Issued when detached from target for any reason (including `detachFromTarget` command). Can be issued multiple times per target if multiple sessions have been attached to it.
+type TargetDetachedFromTargetEvent struct {
+	// Detached session identifier.
+	SessionID TargetSessionID `json:"sessionId"`
+	// Deprecated.
+	TargetID TargetTargetID `json:"targetId,omitempty"`
+}
+
+// TargetReceivedMessageFromTargetEvent is the Target.receivedMessageFromTarget event. Notifies about a new protocol message received from the session (as reported in `attachedToTarget` event).
+type TargetReceivedMessageFromTargetEvent struct {
+	// Identifier of a session which sends a message.
+	SessionID TargetSessionID `json:"sessionId"`
+	Message   string          `json:"message"`
+	// Deprecated.
+	TargetID TargetTargetID `json:"targetId,omitempty"`
+}
+
+// TargetTargetCreatedEvent is the Target.targetCreated event. Issued when a possible inspection target is created.
+type TargetTargetCreatedEvent struct {
+	TargetInfo TargetTargetInfo `json:"targetInfo"`
+}
+
+// TargetTargetDestroyedEvent is the Target.targetDestroyed event. Issued when a target is destroyed.
+type TargetTargetDestroyedEvent struct {
+	TargetID TargetTargetID `json:"targetId"`
+}
+
+// TargetTargetCrashedEvent is the Target.targetCrashed event. Issued when a target has crashed.
+type TargetTargetCrashedEvent struct {
+	TargetID TargetTargetID `json:"targetId"`
+	// Termination status type.
+	Status string `json:"status"`
+	// Termination error code.
+	ErrorCode int `json:"errorCode"`
+}
+
+// TargetTargetInfoChangedEvent is the Target.targetInfoChanged event. Issued when some information about a target has changed. This only happens between `targetCreated` and `targetDestroyed`.
+type TargetTargetInfoChangedEvent struct {
+	TargetInfo TargetTargetInfo `json:"targetInfo"`
+}
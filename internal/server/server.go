@@ -27,6 +27,23 @@ func SetupAndRun() error {
 	// Start Debugger Route - support both GET and POST
 	app.Post("/start-debugger", handlers.HandleDebugger)
 
+	// Background capture jobs
+	app.Post("/jobs", handlers.StartJob)
+	app.Get("/jobs/:id", handlers.GetJob)
+	app.Delete("/jobs/:id", handlers.CancelJob)
+
+	// Long running watches
+	app.Post("/watch", handlers.StartWatch)
+	app.Get("/watch", handlers.GetWatches)
+	app.Delete("/watch/:id", handlers.StopWatch)
+
+	// Live event streams
+	app.Get("/stream", handlers.StreamEvents)
+	app.Get("/stream/ws", handlers.StreamWebSocket)
+
+	// Configured browser endpoints
+	app.Get("/browsers", handlers.GetBrowsers)
+
 	// Sessions route
 	app.Get("/sessions", handlers.GetSessions)
 	app.Delete("/sessions", handlers.ClearSessions)
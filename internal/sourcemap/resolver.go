@@ -0,0 +1,317 @@
+package sourcemap
+
+import (
+	"container/list"
+	"context"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// maxMapSize caps how much of a source map is read
+const maxMapSize = 64 << 20
+
+// maxCachedMaps is how many parsed maps a Cache keeps; the least recently
+// used ones are dropped first
+const maxCachedMaps = 128
+
+// Script is what Debugger.scriptParsed reports about a loaded script. Hash
+// is the hash of the script's content, which changes with every rebuild
+// even when the URL does not.
+type Script struct {
+	ID           string
+	URL          string
+	SourceMapURL string
+	Hash         string
+}
+
+type cacheEntry struct {
+	key  string
+	once sync.Once
+	m    *Map
+	err  error
+}
+
+// Cache holds parsed source maps keyed by where they were loaded from and
+// the content of the script, so repeat captures of the same build only
+// fetch and decode each map once.
+type Cache struct {
+	mu      sync.Mutex
+	entries map[string]*list.Element
+	order   *list.List // most recently used first
+}
+
+// NewCache creates an empty source map cache
+func NewCache() *Cache {
+	return &Cache{
+		entries: make(map[string]*list.Element),
+		order:   list.New(),
+	}
+}
+
+func (c *Cache) load(key string, fetch func() ([]byte, error)) (*Map, error) {
+	c.mu.Lock()
+	var entry *cacheEntry
+	if elem, ok := c.entries[key]; ok {
+		c.order.MoveToFront(elem)
+		entry = elem.Value.(*cacheEntry)
+	} else {
+		entry = &cacheEntry{key: key}
+		c.entries[key] = c.order.PushFront(entry)
+		for c.order.Len() > maxCachedMaps {
+			oldest := c.order.Remove(c.order.Back()).(*cacheEntry)
+			delete(c.entries, oldest.key)
+		}
+	}
+	c.mu.Unlock()
+
+	entry.once.Do(func() {
+		data, err := fetch()
+		if err != nil {
+			entry.err = err
+			return
+		}
+		entry.m, entry.err = Parse(data)
+	})
+
+	// Failures are not remembered so a later capture can retry
+	if entry.err != nil {
+		c.mu.Lock()
+		if elem, ok := c.entries[key]; ok && elem.Value == entry {
+			c.order.Remove(elem)
+			delete(c.entries, key)
+		}
+		c.mu.Unlock()
+	}
+	return entry.m, entry.err
+}
+
+// Resolver maps generated positions back to original sources for a single
+// debugging session. Scripts are registered as they are parsed; maps are
+// loaded lazily from the configured build directory or the script's
+// sourceMapURL and remembered per script.
+type Resolver struct {
+	cache  *Cache
+	dir    string
+	client *http.Client
+
+	mu      sync.Mutex
+	scripts map[string]Script
+	byURL   map[string]string
+	maps    map[string]*Map
+}
+
+// NewResolver creates a resolver backed by cache. dir is an optional local
+// build output directory (such as a Next.js ".next" folder) to read maps from.
+func NewResolver(cache *Cache, dir string) *Resolver {
+	return &Resolver{
+		cache:   cache,
+		dir:     dir,
+		client:  &http.Client{Timeout: 10 * time.Second},
+		scripts: make(map[string]Script),
+		byURL:   make(map[string]string),
+		maps:    make(map[string]*Map),
+	}
+}
+
+// AddScript registers a parsed script
+func (r *Resolver) AddScript(script Script) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.scripts[script.ID] = script
+	if script.URL != "" {
+		r.byURL[script.URL] = script.ID
+	}
+}
+
+// Resolve looks up the original position of a zero-based generated
+// location. scriptID is preferred; url is used when the id is unknown.
+func (r *Resolver) Resolve(ctx context.Context, scriptID, scriptURL string, line, column int) (Position, bool) {
+	m := r.mapFor(ctx, scriptID, scriptURL)
+	if m == nil {
+		return Position{}, false
+	}
+	return m.Lookup(line, column)
+}
+
+func (r *Resolver) mapFor(ctx context.Context, scriptID, scriptURL string) *Map {
+	r.mu.Lock()
+	script, ok := r.scripts[scriptID]
+	if !ok && scriptURL != "" {
+		if id, found := r.byURL[scriptURL]; found {
+			script, ok = r.scripts[id]
+		}
+	}
+	if !ok {
+		script = Script{ID: "url:" + scriptURL, URL: scriptURL}
+	}
+	if m, cached := r.maps[script.ID]; cached {
+		r.mu.Unlock()
+		return m
+	}
+	r.mu.Unlock()
+
+	m, err := r.load(ctx, script)
+	if err != nil {
+		fmt.Printf("⚠️ No source map for %s: %v\n", script.URL, err)
+	}
+
+	r.mu.Lock()
+	r.maps[script.ID] = m
+	r.mu.Unlock()
+	return m
+}
+
+func (r *Resolver) load(ctx context.Context, script Script) (*Map, error) {
+	if script.URL == "" {
+		return nil, fmt.Errorf("script has no url")
+	}
+
+	if r.dir != "" {
+		if file, info := r.findLocal(script); file != "" {
+			key := fmt.Sprintf("file:%s@%d", file, info.ModTime().UnixNano())
+			return r.cache.load(key, func() ([]byte, error) {
+				return readFile(file)
+			})
+		}
+	}
+
+	if script.SourceMapURL == "" {
+		return nil, fmt.Errorf("no sourceMappingURL")
+	}
+
+	if strings.HasPrefix(script.SourceMapURL, "data:") {
+		sum := sha1.Sum([]byte(script.SourceMapURL))
+		return r.cache.load("data:"+hex.EncodeToString(sum[:]), func() ([]byte, error) {
+			return decodeDataURL(script.SourceMapURL)
+		})
+	}
+
+	mapURL, err := resolveReference(script.URL, script.SourceMapURL)
+	if err != nil {
+		return nil, err
+	}
+	// The page picks the sourceMappingURL, so only a local script may point
+	// at a local file; local builds are read from the build directory
+	if strings.HasPrefix(mapURL, "file:") && !strings.HasPrefix(script.URL, "file:") {
+		return nil, fmt.Errorf("refusing local source map %s for %s", mapURL, script.URL)
+	}
+
+	// Dev servers serve every rebuild of a chunk at the same URL
+	key := mapURL
+	if script.Hash != "" {
+		key += "#" + script.Hash
+	}
+	return r.cache.load(key, func() ([]byte, error) {
+		return r.fetch(ctx, mapURL)
+	})
+}
+
+// findLocal looks for "<path>.map" under the build directory, dropping
+// leading path segments so "/_next/static/chunks/x.js" also matches
+// "<dir>/static/chunks/x.js.map".
+func (r *Resolver) findLocal(script Script) (string, os.FileInfo) {
+	u, err := url.Parse(script.URL)
+	if err != nil {
+		return "", nil
+	}
+
+	var names []string
+	if script.SourceMapURL != "" && !strings.HasPrefix(script.SourceMapURL, "data:") && !strings.Contains(script.SourceMapURL, "://") {
+		names = append(names, path.Join(path.Dir(u.Path), script.SourceMapURL))
+	}
+	names = append(names, u.Path+".map")
+
+	for _, name := range names {
+		segments := strings.Split(strings.TrimPrefix(name, "/"), "/")
+		for i := range segments {
+			file := filepath.Join(r.dir, filepath.FromSlash(strings.Join(segments[i:], "/")))
+			if info, err := os.Stat(file); err == nil && info.Mode().IsRegular() {
+				return file, info
+			}
+		}
+	}
+	return "", nil
+}
+
+func (r *Resolver) fetch(ctx context.Context, mapURL string) ([]byte, error) {
+	if strings.HasPrefix(mapURL, "file://") {
+		u, err := url.Parse(mapURL)
+		if err != nil {
+			return nil, err
+		}
+		return readFile(filepath.FromSlash(u.Path))
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, mapURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fetching %s: %s", mapURL, resp.Status)
+	}
+	return io.ReadAll(io.LimitReader(resp.Body, maxMapSize))
+}
+
+// readFile reads a source map from disk. Anything but a regular file, such
+// as a device or a FIFO, is refused so a read cannot hang or run forever.
+func readFile(name string) ([]byte, error) {
+	// Opening a FIFO blocks, so the check comes first
+	info, err := os.Stat(name)
+	if err != nil {
+		return nil, err
+	}
+	if !info.Mode().IsRegular() {
+		return nil, fmt.Errorf("%s is not a regular file", name)
+	}
+
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return io.ReadAll(io.LimitReader(f, maxMapSize))
+}
+
+func resolveReference(base, ref string) (string, error) {
+	baseURL, err := url.Parse(base)
+	if err != nil {
+		return "", err
+	}
+	refURL, err := url.Parse(ref)
+	if err != nil {
+		return "", err
+	}
+	return baseURL.ResolveReference(refURL).String(), nil
+}
+
+func decodeDataURL(dataURL string) ([]byte, error) {
+	meta, payload, ok := strings.Cut(strings.TrimPrefix(dataURL, "data:"), ",")
+	if !ok {
+		return nil, fmt.Errorf("malformed data url")
+	}
+	if strings.HasSuffix(meta, ";base64") {
+		return base64.StdEncoding.DecodeString(payload)
+	}
+	decoded, err := url.PathUnescape(payload)
+	if err != nil {
+		return nil, err
+	}
+	return []byte(decoded), nil
+}
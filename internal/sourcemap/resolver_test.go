@@ -0,0 +1,194 @@
+package sourcemap
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+const testMap = `{"version":3,"sources":["app.tsx"],"names":[],"mappings":"AAAA;AACA"}`
+
+func writeFile(t *testing.T, dir, name string) string {
+	t.Helper()
+	file := filepath.Join(dir, filepath.FromSlash(name))
+	if err := os.MkdirAll(filepath.Dir(file), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(file, []byte(testMap), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return file
+}
+
+func TestFindLocal(t *testing.T) {
+	dir := t.TempDir()
+	chunk := writeFile(t, dir, "static/chunks/app.js.map")
+	named := writeFile(t, dir, "static/chunks/maps/page.map")
+
+	tests := []struct {
+		script Script
+		want   string
+	}{
+		// "/_next" is dropped to match the build directory layout
+		{Script{URL: "http://localhost:3000/_next/static/chunks/app.js"}, chunk},
+		{Script{URL: "http://localhost:3000/static/chunks/app.js?v=1"}, chunk},
+		// A relative sourceMappingURL is tried first
+		{Script{URL: "http://localhost:3000/_next/static/chunks/page.js", SourceMapURL: "maps/page.map"}, named},
+		{Script{URL: "http://localhost:3000/_next/static/chunks/missing.js"}, ""},
+		{Script{URL: "http://localhost:3000/_next/static/chunks/page.js", SourceMapURL: "data:application/json,{}"}, ""},
+	}
+
+	r := NewResolver(NewCache(), dir)
+	for _, tt := range tests {
+		if got, _ := r.findLocal(tt.script); got != tt.want {
+			t.Errorf("findLocal(%+v) = %q, want %q", tt.script, got, tt.want)
+		}
+	}
+}
+
+func TestDecodeDataURL(t *testing.T) {
+	tests := []struct {
+		url  string
+		want string
+	}{
+		{"data:application/json;base64," + base64.StdEncoding.EncodeToString([]byte(testMap)), testMap},
+		{"data:application/json;charset=utf-8;base64," + base64.StdEncoding.EncodeToString([]byte(testMap)), testMap},
+		{"data:application/json,%7B%22version%22%3A3%7D", `{"version":3}`},
+	}
+	for _, tt := range tests {
+		got, err := decodeDataURL(tt.url)
+		if err != nil || string(got) != tt.want {
+			t.Errorf("decodeDataURL(%q) = %q, %v, want %q", tt.url, got, err, tt.want)
+		}
+	}
+	if _, err := decodeDataURL("data:application/json"); err == nil {
+		t.Error("decodeDataURL without a payload succeeded")
+	}
+}
+
+func TestResolveReference(t *testing.T) {
+	tests := []struct {
+		base, ref, want string
+	}{
+		{"http://localhost:3000/_next/static/chunks/app.js", "app.js.map", "http://localhost:3000/_next/static/chunks/app.js.map"},
+		{"http://localhost:3000/_next/static/chunks/app.js", "/maps/app.js.map", "http://localhost:3000/maps/app.js.map"},
+		{"http://localhost:3000/app.js", "https://cdn.example.com/app.js.map", "https://cdn.example.com/app.js.map"},
+	}
+	for _, tt := range tests {
+		if got, err := resolveReference(tt.base, tt.ref); err != nil || got != tt.want {
+			t.Errorf("resolveReference(%q, %q) = %q, %v, want %q", tt.base, tt.ref, got, err, tt.want)
+		}
+	}
+}
+
+func TestResolveFromDataURL(t *testing.T) {
+	r := NewResolver(NewCache(), "")
+	r.AddScript(Script{
+		ID:           "7",
+		URL:          "http://localhost:3000/app.js",
+		SourceMapURL: "data:application/json;base64," + base64.StdEncoding.EncodeToString([]byte(testMap)),
+	})
+
+	pos, ok := r.Resolve(context.Background(), "7", "", 1, 0)
+	if !ok || pos.Source != "app.tsx" || pos.Line != 1 {
+		t.Errorf("Resolve by ID = %+v, %v", pos, ok)
+	}
+	// Frames without a script ID fall back to the URL
+	pos, ok = r.Resolve(context.Background(), "", "http://localhost:3000/app.js", 0, 0)
+	if !ok || pos.Source != "app.tsx" || pos.Line != 0 {
+		t.Errorf("Resolve by URL = %+v, %v", pos, ok)
+	}
+}
+
+func TestLocalMapsOnlyForLocalScripts(t *testing.T) {
+	file := writeFile(t, t.TempDir(), "app.js.map")
+	mapURL := "file://" + filepath.ToSlash(file)
+
+	r := NewResolver(NewCache(), "")
+	if _, err := r.load(context.Background(), Script{ID: "1", URL: "https://evil.example/app.js", SourceMapURL: mapURL}); err == nil {
+		t.Error("a remote script loaded a local source map")
+	}
+	if _, err := r.load(context.Background(), Script{ID: "2", URL: "https://evil.example/app.js", SourceMapURL: "file:app.js.map"}); err == nil {
+		t.Error("a remote script loaded a relative file: source map")
+	}
+	if _, err := r.load(context.Background(), Script{ID: "3", URL: "file:///build/app.js", SourceMapURL: mapURL}); err != nil {
+		t.Errorf("a local script could not load its local map: %v", err)
+	}
+}
+
+func TestReadFileRefusesSpecialFiles(t *testing.T) {
+	if _, err := readFile(os.DevNull); err == nil {
+		t.Errorf("readFile(%s) succeeded", os.DevNull)
+	}
+	if _, err := readFile(t.TempDir()); err == nil {
+		t.Error("readFile of a directory succeeded")
+	}
+}
+
+func TestCacheKeysByScriptHash(t *testing.T) {
+	// Every rebuild is served at the same URL
+	builds := []string{
+		`{"version":3,"sources":["v1.tsx"],"names":[],"mappings":"AAAA"}`,
+		`{"version":3,"sources":["v2.tsx"],"names":[],"mappings":"AAAA"}`,
+	}
+	served := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(builds[served]))
+		served++
+	}))
+	defer server.Close()
+
+	cache := NewCache()
+	scriptURL := server.URL + "/_next/static/chunks/app/page.js"
+	tests := []struct {
+		hash string
+		want string
+	}{
+		{"aaa", "v1.tsx"},
+		{"aaa", "v1.tsx"}, // cached
+		{"bbb", "v2.tsx"},
+	}
+	for i, tt := range tests {
+		r := NewResolver(cache, "")
+		r.AddScript(Script{ID: "7", URL: scriptURL, SourceMapURL: "page.js.map", Hash: tt.hash})
+		if pos, ok := r.Resolve(context.Background(), "7", "", 0, 0); !ok || pos.Source != tt.want {
+			t.Errorf("capture %d resolved %+v, %v, want %s", i, pos, ok, tt.want)
+		}
+	}
+	if served != 2 {
+		t.Errorf("fetched the map %d times, want 2", served)
+	}
+}
+
+func TestCacheEvictsLeastRecentlyUsed(t *testing.T) {
+	c := NewCache()
+	fetches := 0
+	fetch := func() ([]byte, error) {
+		fetches++
+		return []byte(testMap), nil
+	}
+
+	for i := 0; i < maxCachedMaps; i++ {
+		c.load(fmt.Sprint(i), fetch)
+	}
+	c.load("0", fetch) // used again, so "1" is now the oldest
+	c.load("new", fetch)
+	if len(c.entries) != maxCachedMaps || c.order.Len() != maxCachedMaps {
+		t.Errorf("cache holds %d entries, want %d", len(c.entries), maxCachedMaps)
+	}
+
+	fetches = 0
+	c.load("0", fetch)
+	if fetches != 0 {
+		t.Error("a recently used map was evicted")
+	}
+	c.load("1", fetch)
+	if fetches != 1 {
+		t.Error("the least recently used map was kept")
+	}
+}
@@ -0,0 +1,218 @@
+package sourcemap
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Position is a location in an original source file (zero-based)
+type Position struct {
+	Source string
+	Line   int
+	Column int
+	Name   string
+}
+
+// segment is one decoded mapping of a generated line
+type segment struct {
+	genColumn int
+	source    int
+	line      int
+	column    int
+	name      int
+	hasSource bool
+}
+
+// section is one part of an index map, placed at a generated offset
+type section struct {
+	line   int
+	column int
+	m      *Map
+}
+
+// Map is a parsed revision 3 source map. Index maps (with "sections", as
+// emitted by Turbopack) are supported and resolved through their parts.
+type Map struct {
+	sources  []string
+	names    []string
+	lines    [][]segment
+	sections []section
+}
+
+type rawMap struct {
+	Version    int      `json:"version"`
+	SourceRoot string   `json:"sourceRoot"`
+	Sources    []string `json:"sources"`
+	Names      []string `json:"names"`
+	Mappings   string   `json:"mappings"`
+	Sections   []struct {
+		Offset struct {
+			Line   int `json:"line"`
+			Column int `json:"column"`
+		} `json:"offset"`
+		Map json.RawMessage `json:"map"`
+	} `json:"sections"`
+}
+
+// Parse decodes a source map document
+func Parse(data []byte) (*Map, error) {
+	// Some servers prefix maps with an XSSI guard line
+	if len(data) > 0 && data[0] == ')' {
+		if i := strings.IndexByte(string(data), '\n'); i >= 0 {
+			data = data[i+1:]
+		}
+	}
+
+	var raw rawMap
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("invalid source map: %v", err)
+	}
+	if raw.Version != 3 {
+		return nil, fmt.Errorf("unsupported source map version %d", raw.Version)
+	}
+
+	m := &Map{names: raw.Names}
+
+	if len(raw.Sections) > 0 {
+		for _, s := range raw.Sections {
+			sub, err := Parse(s.Map)
+			if err != nil {
+				return nil, err
+			}
+			m.sections = append(m.sections, section{line: s.Offset.Line, column: s.Offset.Column, m: sub})
+		}
+		return m, nil
+	}
+
+	m.sources = make([]string, len(raw.Sources))
+	for i, source := range raw.Sources {
+		if raw.SourceRoot != "" && !strings.Contains(source, "://") {
+			source = strings.TrimSuffix(raw.SourceRoot, "/") + "/" + source
+		}
+		m.sources[i] = source
+	}
+
+	lines, err := decodeMappings(raw.Mappings)
+	if err != nil {
+		return nil, err
+	}
+	m.lines = lines
+	return m, nil
+}
+
+// Lookup finds the original position of a zero-based generated line and column
+func (m *Map) Lookup(line, column int) (Position, bool) {
+	if len(m.sections) > 0 {
+		i := sort.Search(len(m.sections), func(i int) bool {
+			s := m.sections[i]
+			return s.line > line || (s.line == line && s.column > column)
+		}) - 1
+		if i < 0 {
+			return Position{}, false
+		}
+		s := m.sections[i]
+		if line == s.line {
+			column -= s.column
+		}
+		return s.m.Lookup(line-s.line, column)
+	}
+
+	if line < 0 || line >= len(m.lines) {
+		return Position{}, false
+	}
+	segments := m.lines[line]
+	i := sort.Search(len(segments), func(i int) bool {
+		return segments[i].genColumn > column
+	}) - 1
+	if i < 0 || !segments[i].hasSource {
+		return Position{}, false
+	}
+
+	seg := segments[i]
+	pos := Position{Line: seg.line, Column: seg.column}
+	if seg.source >= 0 && seg.source < len(m.sources) {
+		pos.Source = m.sources[seg.source]
+	}
+	if seg.name >= 0 && seg.name < len(m.names) {
+		pos.Name = m.names[seg.name]
+	}
+	return pos, true
+}
+
+func decodeMappings(mappings string) ([][]segment, error) {
+	var (
+		lines                      [][]segment
+		source, line, column, name int
+	)
+
+	for _, encodedLine := range strings.Split(mappings, ";") {
+		var segments []segment
+		genColumn := 0
+
+		for _, encoded := range strings.Split(encodedLine, ",") {
+			if encoded == "" {
+				continue
+			}
+			fields, err := decodeVLQ(encoded)
+			if err != nil {
+				return nil, err
+			}
+
+			genColumn += fields[0]
+			seg := segment{genColumn: genColumn, source: -1, name: -1}
+			if len(fields) >= 4 {
+				source += fields[1]
+				line += fields[2]
+				column += fields[3]
+				seg.source, seg.line, seg.column, seg.hasSource = source, line, column, true
+			}
+			if len(fields) >= 5 {
+				name += fields[4]
+				seg.name = name
+			}
+			segments = append(segments, seg)
+		}
+
+		sort.SliceStable(segments, func(i, j int) bool {
+			return segments[i].genColumn < segments[j].genColumn
+		})
+		lines = append(lines, segments)
+	}
+
+	return lines, nil
+}
+
+const base64Chars = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789+/"
+
+// decodeVLQ decodes one base64 VLQ segment into its signed fields
+func decodeVLQ(encoded string) ([]int, error) {
+	fields := make([]int, 0, 5)
+	value, shift := 0, 0
+
+	for i := 0; i < len(encoded); i++ {
+		digit := strings.IndexByte(base64Chars, encoded[i])
+		if digit < 0 {
+			return nil, fmt.Errorf("invalid VLQ character %q", encoded[i])
+		}
+
+		value += (digit & 0x1f) << shift
+		if digit&0x20 != 0 {
+			shift += 5
+			continue
+		}
+
+		if value&1 != 0 {
+			fields = append(fields, -(value >> 1))
+		} else {
+			fields = append(fields, value>>1)
+		}
+		value, shift = 0, 0
+	}
+
+	if shift != 0 {
+		return nil, fmt.Errorf("truncated VLQ segment %q", encoded)
+	}
+	return fields, nil
+}
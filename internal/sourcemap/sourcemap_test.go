@@ -0,0 +1,147 @@
+package sourcemap
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDecodeVLQ(t *testing.T) {
+	tests := []struct {
+		encoded string
+		want    []int
+	}{
+		{"A", []int{0}},
+		{"C", []int{1}},
+		{"D", []int{-1}},
+		{"gB", []int{16}},
+		{"hB", []int{-16}},
+		{"AAAA", []int{0, 0, 0, 0}},
+		{"SAAQA", []int{9, 0, 0, 8, 0}},
+		{"2HwcqxB", []int{123, 456, 789}},
+		{"2HwcrxB", []int{123, 456, -789}},
+	}
+	for _, tt := range tests {
+		got, err := decodeVLQ(tt.encoded)
+		if err != nil {
+			t.Errorf("decodeVLQ(%q): %v", tt.encoded, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("decodeVLQ(%q) = %v, want %v", tt.encoded, got, tt.want)
+		}
+	}
+}
+
+func TestDecodeVLQErrors(t *testing.T) {
+	for _, encoded := range []string{"g", "A!", "AA*A"} {
+		if _, err := decodeVLQ(encoded); err == nil {
+			t.Errorf("decodeVLQ(%q) succeeded, want an error", encoded)
+		}
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	for _, data := range []string{
+		`not json`,
+		`{"version":2,"sources":[],"mappings":""}`,
+		`{"version":3,"sources":["a.js"],"mappings":"A!"}`,
+		`{"version":3,"sections":[{"offset":{"line":0,"column":0},"map":{"version":2}}]}`,
+	} {
+		if _, err := Parse([]byte(data)); err == nil {
+			t.Errorf("Parse(%s) succeeded, want an error", data)
+		}
+	}
+}
+
+func TestLookup(t *testing.T) {
+	// Line 0: column 0 -> a.js 0:0, column 4 -> a.js 0:2 named "foo",
+	// column 9 has no source. Line 1: column 2 -> b.js 3:0.
+	m, err := Parse([]byte(`{
+		"version": 3,
+		"sourceRoot": "webpack:///src/",
+		"sources": ["a.js", "b.js", "https://cdn.example.com/c.js"],
+		"names": ["foo"],
+		"mappings": "AAAA,IAAEA,K;ECGF"
+	}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		line, column int
+		want         Position
+		ok           bool
+	}{
+		{0, 0, Position{Source: "webpack:///src/a.js", Line: 0, Column: 0}, true},
+		{0, 3, Position{Source: "webpack:///src/a.js", Line: 0, Column: 0}, true},
+		{0, 4, Position{Source: "webpack:///src/a.js", Line: 0, Column: 2, Name: "foo"}, true},
+		{0, 8, Position{Source: "webpack:///src/a.js", Line: 0, Column: 2, Name: "foo"}, true},
+		{0, 9, Position{}, false},
+		{1, 0, Position{}, false},
+		{1, 2, Position{Source: "webpack:///src/b.js", Line: 3, Column: 0}, true},
+		{1, 100, Position{Source: "webpack:///src/b.js", Line: 3, Column: 0}, true},
+		{2, 0, Position{}, false},
+		{-1, 0, Position{}, false},
+	}
+	for _, tt := range tests {
+		got, ok := m.Lookup(tt.line, tt.column)
+		if ok != tt.ok || got != tt.want {
+			t.Errorf("Lookup(%d, %d) = %+v, %v, want %+v, %v", tt.line, tt.column, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestParseSourceRoot(t *testing.T) {
+	m, err := Parse([]byte(`{"version":3,"sourceRoot":"/src","sources":["a.js","https://cdn.example.com/c.js"],"mappings":"AAAA;ACAA"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"/src/a.js", "https://cdn.example.com/c.js"}
+	if !reflect.DeepEqual(m.sources, want) {
+		t.Errorf("sources = %v, want %v", m.sources, want)
+	}
+}
+
+func TestParseXSSIPrefix(t *testing.T) {
+	m, err := Parse([]byte(")]}'\n" + `{"version":3,"sources":["a.js"],"mappings":"AAAA"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pos, ok := m.Lookup(0, 0); !ok || pos.Source != "a.js" {
+		t.Errorf("Lookup(0, 0) = %+v, %v", pos, ok)
+	}
+}
+
+func TestLookupSections(t *testing.T) {
+	// Two sections: x.js from the start, a.js from line 5 column 10
+	m, err := Parse([]byte(`{
+		"version": 3,
+		"sections": [
+			{"offset": {"line": 0, "column": 0}, "map": {"version": 3, "sources": ["x.js"], "names": [], "mappings": "AAAA"}},
+			{"offset": {"line": 5, "column": 10}, "map": {"version": 3, "sources": ["a.js"], "names": ["foo"], "mappings": "AAAA;AACA,IAAIA"}}
+		]
+	}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		line, column int
+		want         Position
+		ok           bool
+	}{
+		{0, 0, Position{Source: "x.js"}, true},
+		{5, 9, Position{}, false}, // past the end of x.js's one line
+		{5, 10, Position{Source: "a.js"}, true},
+		{5, 15, Position{Source: "a.js"}, true},
+		// Only the section's first line is shifted by its column
+		{6, 4, Position{Source: "a.js", Line: 1, Column: 4, Name: "foo"}, true},
+		{6, 0, Position{Source: "a.js", Line: 1, Column: 0}, true},
+	}
+	for _, tt := range tests {
+		got, ok := m.Lookup(tt.line, tt.column)
+		if ok != tt.ok || got != tt.want {
+			t.Errorf("Lookup(%d, %d) = %+v, %v, want %+v, %v", tt.line, tt.column, got, ok, tt.want, tt.ok)
+		}
+	}
+}
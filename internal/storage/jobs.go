@@ -0,0 +1,277 @@
+package storage
+
+import (
+	"debugger-api/internal/debugger"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Job states
+const (
+	JobQueued      = "queued"
+	JobRunning     = "running"
+	JobDone        = "done"
+	JobFailed      = "failed"
+	JobCancelled   = "cancelled"
+	JobInterrupted = "interrupted" // the server stopped while the job was running
+)
+
+const (
+	// maxFinishedJobs is how many finished jobs are kept; older ones are dropped
+	maxFinishedJobs = 100
+	// persistDelay batches the target progress of running jobs into one write
+	persistDelay = time.Second
+)
+
+// Job is a capture running in the background
+type Job struct {
+	ID         string                  `json:"id"`
+	Status     string                  `json:"status"`
+	Request    debugger.DebugRequest   `json:"request"`
+	Targets    map[string]JobTarget    `json:"targets"` // progress keyed by target ID
+	Response   *debugger.DebugResponse `json:"response,omitempty"`
+	Error      string                  `json:"error,omitempty"`
+	CreatedAt  time.Time               `json:"createdAt"`
+	UpdatedAt  time.Time               `json:"updatedAt"`
+	FinishedAt *time.Time              `json:"finishedAt,omitempty"`
+}
+
+// JobTarget is the progress of one target within a job
+type JobTarget struct {
+	State string `json:"state"`
+	URL   string `json:"url"`
+	Error string `json:"error,omitempty"`
+}
+
+// Finished reports whether the job has stopped running
+func (j *Job) Finished() bool {
+	return j.Status != JobQueued && j.Status != JobRunning
+}
+
+// JobStore keeps capture jobs in memory and in jobs.json. The response of
+// each job is kept in its own file under jobs/ and only read when asked for.
+type JobStore struct {
+	mu      sync.RWMutex
+	jobs    map[string]*Job
+	dataDir string
+	flush   *time.Timer // pending write of progress-only updates
+}
+
+// NewJobStore loads the persisted jobs. Jobs that were still running when
+// the server stopped cannot be resumed and are marked interrupted.
+func NewJobStore(dataDir string) (*JobStore, error) {
+	if err := os.MkdirAll(filepath.Join(dataDir, "jobs"), 0755); err != nil {
+		return nil, err
+	}
+
+	s := &JobStore{
+		jobs:    make(map[string]*Job),
+		dataDir: dataDir,
+	}
+	if err := s.load(); err != nil {
+		return nil, err
+	}
+
+	interrupted, moved := 0, 0
+	for _, job := range s.jobs {
+		// Older versions kept responses in jobs.json
+		if job.Response != nil {
+			if err := s.SaveResponse(job.ID, job.Response); err != nil {
+				return nil, err
+			}
+			job.Response = nil
+			moved++
+		}
+		if !job.Finished() {
+			now := time.Now()
+			job.Status = JobInterrupted
+			job.UpdatedAt = now
+			job.FinishedAt = &now
+			interrupted++
+		}
+	}
+	pruned := s.prune()
+	if interrupted > 0 {
+		fmt.Printf("⚠️ Marked %d unfinished jobs as interrupted\n", interrupted)
+	}
+	if interrupted > 0 || pruned > 0 || moved > 0 {
+		if err := s.persist(); err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+// Create registers a new job
+func (s *JobStore) Create(job *Job) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	job.CreatedAt = now
+	job.UpdatedAt = now
+	if job.Targets == nil {
+		job.Targets = make(map[string]JobTarget)
+	}
+	s.jobs[job.ID] = job
+	return s.persist()
+}
+
+// Update applies fn to a job and persists the result. Status changes are
+// written right away; progress within a status is written after
+// persistDelay, together with any other progress in the meantime.
+func (s *JobStore) Update(id string, fn func(job *Job)) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	job, ok := s.jobs[id]
+	if !ok {
+		return fmt.Errorf("job %s not found", id)
+	}
+	status := job.Status
+	fn(job)
+	now := time.Now()
+	job.UpdatedAt = now
+	if job.Status == status {
+		if s.flush == nil {
+			s.flush = time.AfterFunc(persistDelay, s.flushProgress)
+		}
+		return nil
+	}
+	if job.Finished() {
+		if job.FinishedAt == nil {
+			job.FinishedAt = &now
+		}
+		s.prune()
+	}
+	return s.persist()
+}
+
+// Get returns a snapshot of a job, with its response once it finished
+func (s *JobStore) Get(id string) (Job, bool) {
+	s.mu.RLock()
+	job, ok := s.jobs[id]
+	if !ok {
+		s.mu.RUnlock()
+		return Job{}, false
+	}
+	snapshot := *job
+	snapshot.Targets = make(map[string]JobTarget, len(job.Targets))
+	for id, target := range job.Targets {
+		snapshot.Targets[id] = target
+	}
+	s.mu.RUnlock()
+
+	if snapshot.Finished() {
+		response, err := s.loadResponse(id)
+		if err != nil {
+			fmt.Printf("❌ Failed to read the response of job %s: %v\n", id, err)
+		}
+		snapshot.Response = response
+	}
+	return snapshot, true
+}
+
+// SaveResponse stores what a job captured. It is saved before the job is
+// marked finished, so a finished job always has its response.
+func (s *JobStore) SaveResponse(id string, response *debugger.DebugResponse) error {
+	data, err := json.Marshal(response)
+	if err != nil {
+		return err
+	}
+	return writeFile(s.responseFile(id), data)
+}
+
+func (s *JobStore) loadResponse(id string) (*debugger.DebugResponse, error) {
+	data, err := os.ReadFile(s.responseFile(id))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var response debugger.DebugResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (s *JobStore) responseFile(id string) string {
+	return filepath.Join(s.dataDir, "jobs", id+".json")
+}
+
+func (s *JobStore) flushProgress() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.flush == nil {
+		return // a status change wrote the progress already
+	}
+	s.flush = nil
+	if err := s.persist(); err != nil {
+		fmt.Printf("❌ Failed to save job progress: %v\n", err)
+	}
+}
+
+// prune drops the oldest finished jobs beyond maxFinishedJobs and returns
+// how many were dropped
+func (s *JobStore) prune() int {
+	var finished []*Job
+	for _, job := range s.jobs {
+		if job.Finished() {
+			finished = append(finished, job)
+		}
+	}
+	if len(finished) <= maxFinishedJobs {
+		return 0
+	}
+
+	sort.Slice(finished, func(i, j int) bool {
+		return finishedAt(finished[i]).After(finishedAt(finished[j]))
+	})
+	for _, job := range finished[maxFinishedJobs:] {
+		delete(s.jobs, job.ID)
+		if err := os.Remove(s.responseFile(job.ID)); err != nil && !os.IsNotExist(err) {
+			fmt.Printf("❌ Failed to remove the response of job %s: %v\n", job.ID, err)
+		}
+	}
+	return len(finished) - maxFinishedJobs
+}
+
+func finishedAt(job *Job) time.Time {
+	if job.FinishedAt != nil {
+		return *job.FinishedAt
+	}
+	return job.UpdatedAt
+}
+
+// persist writes the status and progress of every job to jobs.json. It
+// replaces any pending write of progress-only updates.
+func (s *JobStore) persist() error {
+	if s.flush != nil {
+		s.flush.Stop()
+		s.flush = nil
+	}
+	data, err := json.MarshalIndent(s.jobs, "", "  ")
+	if err != nil {
+		return err
+	}
+	return writeFile(filepath.Join(s.dataDir, "jobs.json"), data)
+}
+
+func (s *JobStore) load() error {
+	data, err := os.ReadFile(filepath.Join(s.dataDir, "jobs.json"))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, &s.jobs)
+}
@@ -0,0 +1,157 @@
+package storage
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"debugger-api/internal/debugger"
+)
+
+func TestJobStorePrunesFinishedJobs(t *testing.T) {
+	dir := t.TempDir()
+	s, err := NewJobStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < maxFinishedJobs+5; i++ {
+		id := fmt.Sprintf("job-%03d", i)
+		if err := s.Create(&Job{ID: id, Status: JobRunning}); err != nil {
+			t.Fatal(err)
+		}
+		if err := s.SaveResponse(id, &debugger.DebugResponse{}); err != nil {
+			t.Fatal(err)
+		}
+		if err := s.Update(id, func(job *Job) { job.Status = JobDone }); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := s.Create(&Job{ID: "running", Status: JobRunning}); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := s.Get("job-004"); ok {
+		t.Error("an old finished job was kept")
+	}
+	if _, ok := s.Get("job-005"); !ok {
+		t.Error("a recent finished job was dropped")
+	}
+
+	reloaded, err := NewJobStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The running job is interrupted on load and counts as finished
+	if n := len(reloaded.jobs); n != maxFinishedJobs {
+		t.Errorf("reloaded %d jobs, want %d", n, maxFinishedJobs)
+	}
+	if job, _ := reloaded.Get("running"); job.Status != JobInterrupted {
+		t.Errorf("running job reloaded as %q, want %q", job.Status, JobInterrupted)
+	}
+	if _, ok := reloaded.Get("job-005"); ok {
+		t.Error("the oldest finished job was kept on load")
+	}
+	if _, err := os.Stat(reloaded.responseFile("job-004")); !os.IsNotExist(err) {
+		t.Error("the response of a dropped job was kept")
+	}
+}
+
+func TestJobStoreBatchesProgress(t *testing.T) {
+	dir := t.TempDir()
+	s, err := NewJobStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Create(&Job{ID: "job", Status: JobRunning}); err != nil {
+		t.Fatal(err)
+	}
+
+	progress := func(job *Job) { job.Targets["t1"] = JobTarget{State: "capturing"} }
+	if err := s.Update("job", progress); err != nil {
+		t.Fatal(err)
+	}
+	if s.flush == nil {
+		t.Fatal("progress was not scheduled for writing")
+	}
+	reloaded, err := NewJobStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if job, _ := reloaded.Get("job"); len(job.Targets) != 0 {
+		t.Error("progress was written before the delay")
+	}
+
+	// A status change writes the pending progress with it
+	if err := s.Update("job", func(job *Job) { job.Status = JobDone }); err != nil {
+		t.Fatal(err)
+	}
+	if s.flush != nil {
+		t.Error("a write is still pending after the status change")
+	}
+	reloaded, err = NewJobStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if job, _ := reloaded.Get("job"); job.Targets["t1"].State != "capturing" {
+		t.Errorf("targets = %+v after the status change", job.Targets)
+	}
+}
+
+func TestJobResponseFile(t *testing.T) {
+	dir := t.TempDir()
+	s, err := NewJobStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Create(&Job{ID: "job", Status: JobRunning}); err != nil {
+		t.Fatal(err)
+	}
+
+	response := &debugger.DebugResponse{Errors: map[string]string{"t1": "boom"}}
+	if err := s.SaveResponse("job", response); err != nil {
+		t.Fatal(err)
+	}
+	if job, _ := s.Get("job"); job.Response != nil {
+		t.Error("a running job has a response")
+	}
+	if err := s.Update("job", func(job *Job) { job.Status = JobDone }); err != nil {
+		t.Fatal(err)
+	}
+	if job, _ := s.Get("job"); job.Response == nil || job.Response.Errors["t1"] != "boom" {
+		t.Errorf("response = %+v, want the saved one", job.Response)
+	}
+
+	data, err := os.ReadFile(filepath.Join(dir, "jobs.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(data), "boom") {
+		t.Error("jobs.json holds the response")
+	}
+}
+
+func TestJobStoreMovesResponsesOutOfJobsFile(t *testing.T) {
+	dir := t.TempDir()
+	legacy := `{"job":{"id":"job","status":"done","request":{},"targets":{},"response":{"errors":{"t1":"boom"}},"createdAt":"2026-01-02T15:04:05Z","updatedAt":"2026-01-02T15:04:05Z"}}`
+	if err := os.WriteFile(filepath.Join(dir, "jobs.json"), []byte(legacy), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	s, err := NewJobStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if job, _ := s.Get("job"); job.Response == nil || job.Response.Errors["t1"] != "boom" {
+		t.Errorf("response = %+v after moving it", job.Response)
+	}
+	data, err := os.ReadFile(filepath.Join(dir, "jobs.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(data), "boom") {
+		t.Error("jobs.json still holds the response")
+	}
+}
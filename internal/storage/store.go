@@ -1,11 +1,14 @@
 package storage
 
 import (
+	"crypto/sha1"
 	"debugger-api/internal/debugger"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
 	"sync"
 	"time"
 )
@@ -15,14 +18,27 @@ type DebugSession struct {
     Timestamp   time.Time                     `json:"timestamp"`
     Results     map[string]debugger.PageResults `json:"results"`
     Errors      map[string]string            `json:"errors"`
+    Watch       string                        `json:"watch,omitempty"` // ID of the watch that recorded this time bucket
 }
 
+// maxWatchBuckets is how many time buckets are kept per URL, a day of
+// one-minute buckets; older ones are dropped
+const maxWatchBuckets = 1440
+
 type Store struct {
     mu       sync.RWMutex
+    diskMu   sync.Mutex // orders bucket file writes, which happen outside mu
     sessions map[string][]DebugSession // URL -> []Sessions
+    buckets  map[string][]DebugSession // URL -> watch time buckets, one file each under buckets/
     dataDir  string
 }
 
+// bucketFile is the content of a watch bucket's file
+type bucketFile struct {
+    URL     string       `json:"url"`
+    Session DebugSession `json:"session"`
+}
+
 func NewStore(dataDir string) (*Store, error) {
     if err := os.MkdirAll(dataDir, 0755); err != nil {
         return nil, err
@@ -30,6 +46,7 @@ func NewStore(dataDir string) (*Store, error) {
 
     store := &Store{
         sessions: make(map[string][]DebugSession),
+        buckets:  make(map[string][]DebugSession),
         dataDir:  dataDir,
     }
 
@@ -37,11 +54,14 @@ func NewStore(dataDir string) (*Store, error) {
     if err := store.loadSessions(); err != nil {
         return nil, err
     }
+    if err := store.loadBuckets(); err != nil {
+        return nil, err
+    }
 
     return store, nil
 }
 
-func (s *Store) SaveSession(url string, results debugger.PageResults, errors map[string]string) error {
+func (s *Store) SaveSession(url string, results map[string]debugger.PageResults, errors map[string]string) error {
     s.mu.Lock()
     defer s.mu.Unlock()
 
@@ -52,7 +72,7 @@ func (s *Store) SaveSession(url string, results debugger.PageResults, errors map
     session := DebugSession{
         Version:   newVersion,
         Timestamp: time.Now(),
-        Results:   map[string]debugger.PageResults{url: results},
+        Results:   results,
         Errors:    errors,
     }
 
@@ -60,20 +80,153 @@ func (s *Store) SaveSession(url string, results debugger.PageResults, errors map
     return s.persist()
 }
 
+// SaveBucket adds results a watch captured to the session of the time
+// bucket starting at start, creating that session on first use. Buckets
+// are kept apart from the sessions of captures, so starting a capture does
+// not clear them.
+func (s *Store) SaveBucket(url, watch string, start time.Time, results map[string]debugger.PageResults) error {
+    s.mu.Lock()
+
+    buckets := s.buckets[url]
+    i := len(buckets) - 1
+    for ; i >= 0; i-- {
+        if buckets[i].Watch == watch && buckets[i].Timestamp.Equal(start) {
+            break
+        }
+    }
+
+    var dropped []DebugSession
+    if i >= 0 {
+        for id, result := range results {
+            if existing, ok := buckets[i].Results[id]; ok {
+                result = mergeResults(existing, result)
+            }
+            buckets[i].Results[id] = result
+        }
+    } else {
+        fmt.Printf("💾 Saving watch bucket %s for %s\n", start.Format(time.RFC3339), url)
+        version := 1
+        if len(buckets) > 0 {
+            version = buckets[len(buckets)-1].Version + 1
+        }
+        bucket := DebugSession{
+            Version:   version,
+            Timestamp: start,
+            Results:   make(map[string]debugger.PageResults),
+            Errors:    make(map[string]string),
+            Watch:     watch,
+        }
+        for id, result := range results {
+            bucket.Results[id] = result
+        }
+
+        buckets = append(buckets, bucket)
+        if len(buckets) > maxWatchBuckets {
+            dropped = buckets[:len(buckets)-maxWatchBuckets]
+            buckets = append([]DebugSession(nil), buckets[len(buckets)-maxWatchBuckets:]...)
+        }
+        s.buckets[url] = buckets
+        i = len(buckets) - 1
+    }
+
+    bucket := buckets[i]
+    data, err := json.Marshal(bucketFile{URL: url, Session: bucket})
+
+    // Only this bucket's file is written. diskMu is taken before mu is
+    // released so writes land in order without blocking readers.
+    s.diskMu.Lock()
+    s.mu.Unlock()
+    defer s.diskMu.Unlock()
+
+    if err != nil {
+        return err
+    }
+    dir := s.bucketDir(url)
+    for i := range dropped {
+        if err := os.Remove(filepath.Join(dir, bucketName(&dropped[i]))); err != nil && !os.IsNotExist(err) {
+            fmt.Printf("❌ Failed to remove watch bucket: %v\n", err)
+        }
+    }
+    if err := os.MkdirAll(dir, 0755); err != nil {
+        return err
+    }
+    return writeFile(filepath.Join(dir, bucketName(&bucket)), data)
+}
+
+// bucketDir is the directory holding the bucket files of a URL
+func (s *Store) bucketDir(url string) string {
+    sum := sha1.Sum([]byte(url))
+    return filepath.Join(s.dataDir, "buckets", hex.EncodeToString(sum[:]))
+}
+
+func bucketName(bucket *DebugSession) string {
+    return fmt.Sprintf("%d-%s.json", bucket.Timestamp.UnixNano(), bucket.Watch)
+}
+
+// mergeResults appends a later part of a target's capture to an earlier one
+func mergeResults(earlier, later debugger.PageResults) debugger.PageResults {
+    later.Console = append(earlier.Console, later.Console...)
+    later.Errors = append(earlier.Errors, later.Errors...)
+    later.Network = append(earlier.Network, later.Network...)
+    later.Issues = append(earlier.Issues, later.Issues...)
+    later.Navigations = append(earlier.Navigations, later.Navigations...)
+
+    later.Truncated = earlier.Truncated || later.Truncated
+    later.DroppedMessages += earlier.DroppedMessages
+    later.DroppedEvents += earlier.DroppedEvents
+    truncatedBy := earlier.TruncatedBy
+    for _, limit := range later.TruncatedBy {
+        if !contains(truncatedBy, limit) {
+            truncatedBy = append(truncatedBy, limit)
+        }
+    }
+    later.TruncatedBy = truncatedBy
+    return later
+}
+
+func contains(values []string, value string) bool {
+    for _, v := range values {
+        if v == value {
+            return true
+        }
+    }
+    return false
+}
+
+// GetSessions returns the sessions of captures and the watch buckets of a
+// URL, oldest first
 func (s *Store) GetSessions(url string) []DebugSession {
     s.mu.RLock()
     defer s.mu.RUnlock()
-    return s.sessions[url]
+
+    buckets := s.buckets[url]
+    if len(buckets) == 0 {
+        return s.sessions[url]
+    }
+    sessions := make([]DebugSession, 0, len(s.sessions[url])+len(buckets))
+    sessions = append(sessions, s.sessions[url]...)
+    sessions = append(sessions, buckets...)
+    sort.SliceStable(sessions, func(i, j int) bool {
+        return sessions[i].Timestamp.Before(sessions[j].Timestamp)
+    })
+    return sessions
 }
 
 func (s *Store) ClearSessions(url string) error {
     s.mu.Lock()
     defer s.mu.Unlock()
+    s.diskMu.Lock()
+    defer s.diskMu.Unlock()
 
     delete(s.sessions, url)
+    delete(s.buckets, url)
+    if err := os.RemoveAll(s.bucketDir(url)); err != nil {
+        return err
+    }
     return s.persist()
 }
 
+// ClearAllSessions drops the sessions of captures. Watch buckets are kept.
 func (s *Store) ClearAllSessions() error {
     s.mu.Lock()
     defer s.mu.Unlock()
@@ -95,14 +248,20 @@ func (s *Store) ClearAllSessions() error {
 func (s *Store) Cleanup() error {
     s.mu.Lock()
     defer s.mu.Unlock()
+    s.diskMu.Lock()
+    defer s.diskMu.Unlock()
 
     // Clear memory
     s.sessions = make(map[string][]DebugSession)
+    s.buckets = make(map[string][]DebugSession)
     
-    // Remove the data file
+    // Remove the data files
     if err := os.Remove(filepath.Join(s.dataDir, "sessions.json")); err != nil && !os.IsNotExist(err) {
         return err
     }
+    if err := os.RemoveAll(filepath.Join(s.dataDir, "buckets")); err != nil {
+        return err
+    }
     
     return nil
 }
@@ -112,19 +271,43 @@ func (s *Store) persist() error {
     if err != nil {
         return err
     }
-    return os.WriteFile(filepath.Join(s.dataDir, "sessions.json"), data, 0644)
+    return writeFile(filepath.Join(s.dataDir, "sessions.json"), data)
 }
 
 func (s *Store) loadSessions() error {
-    path := filepath.Join(s.dataDir, "sessions.json")
-    if _, err := os.Stat(path); os.IsNotExist(err) {
-        return nil
+    return load(filepath.Join(s.dataDir, "sessions.json"), &s.sessions)
+}
+
+// loadBuckets reads every bucket file. A file that cannot be read is
+// skipped so one bad bucket does not keep the server from starting.
+func (s *Store) loadBuckets() error {
+    files, err := filepath.Glob(filepath.Join(s.dataDir, "buckets", "*", "*.json"))
+    if err != nil {
+        return err
+    }
+    for _, file := range files {
+        var bucket bucketFile
+        if err := load(file, &bucket); err != nil {
+            fmt.Printf("⚠️ Skipping watch bucket %s: %v\n", file, err)
+            continue
+        }
+        s.buckets[bucket.URL] = append(s.buckets[bucket.URL], bucket.Session)
     }
+    for _, buckets := range s.buckets {
+        sort.Slice(buckets, func(i, j int) bool {
+            return buckets[i].Timestamp.Before(buckets[j].Timestamp)
+        })
+    }
+    return nil
+}
 
+func load(path string, v interface{}) error {
     data, err := os.ReadFile(path)
+    if os.IsNotExist(err) {
+        return nil
+    }
     if err != nil {
         return err
     }
-
-    return json.Unmarshal(data, &s.sessions)
+    return json.Unmarshal(data, v)
 } 
\ No newline at end of file
@@ -0,0 +1,119 @@
+package storage
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"debugger-api/internal/debugger"
+)
+
+func TestClearAllSessionsKeepsWatchBuckets(t *testing.T) {
+	dir := t.TempDir()
+	s, err := NewStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const url = "http://localhost:3000"
+	start := time.Now().Truncate(time.Minute)
+	results := map[string]debugger.PageResults{"t1": {}}
+	if err := s.SaveSession(url, results, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.SaveBucket(url, "w1", start, results); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.ClearAllSessions(); err != nil {
+		t.Fatal(err)
+	}
+
+	reloaded, err := NewStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sessions := reloaded.GetSessions(url)
+	if len(sessions) != 1 || sessions[0].Watch != "w1" {
+		t.Errorf("sessions after clearing = %+v, want the watch bucket", sessions)
+	}
+
+	if err := reloaded.ClearSessions(url); err != nil {
+		t.Fatal(err)
+	}
+	if sessions := reloaded.GetSessions(url); len(sessions) != 0 {
+		t.Errorf("%d sessions left after clearing the URL", len(sessions))
+	}
+}
+
+func TestSaveBucketKeepsNewest(t *testing.T) {
+	dir := t.TempDir()
+	s, err := NewStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const url = "http://localhost:3000"
+	start := time.Now().Truncate(time.Minute)
+	results := map[string]debugger.PageResults{"t1": {}}
+	for i := 0; i < maxWatchBuckets+2; i++ {
+		if err := s.SaveBucket(url, "w1", start.Add(time.Duration(i)*time.Minute), results); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	buckets := s.GetSessions(url)
+	if len(buckets) != maxWatchBuckets {
+		t.Fatalf("kept %d buckets, want %d", len(buckets), maxWatchBuckets)
+	}
+	if !buckets[0].Timestamp.Equal(start.Add(2 * time.Minute)) {
+		t.Errorf("oldest bucket starts at %v, want %v", buckets[0].Timestamp, start.Add(2*time.Minute))
+	}
+
+	files, _ := filepath.Glob(filepath.Join(s.bucketDir(url), "*"))
+	if len(files) != maxWatchBuckets {
+		t.Errorf("%d bucket files on disk, want %d", len(files), maxWatchBuckets)
+	}
+	reloaded, err := NewStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := len(reloaded.GetSessions(url)); n != maxWatchBuckets {
+		t.Errorf("reloaded %d buckets, want %d", n, maxWatchBuckets)
+	}
+}
+
+func TestSaveBucketMergesIntoItsFile(t *testing.T) {
+	dir := t.TempDir()
+	s, err := NewStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const url = "http://localhost:3000"
+	start := time.Now().Truncate(time.Minute)
+	first := map[string]debugger.PageResults{"t1": {Console: []debugger.ConsoleMessage{{Message: "one"}}}}
+	second := map[string]debugger.PageResults{"t1": {Console: []debugger.ConsoleMessage{{Message: "two"}}}}
+	if err := s.SaveBucket(url, "w1", start, first); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.SaveBucket(url, "w1", start, second); err != nil {
+		t.Fatal(err)
+	}
+
+	// A file left half-written by a crash does not keep the store from loading
+	if err := os.WriteFile(filepath.Join(s.bucketDir(url), "1-w2.json"), []byte(`{"url":"http`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	reloaded, err := NewStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sessions := reloaded.GetSessions(url)
+	if len(sessions) != 1 {
+		t.Fatalf("reloaded %d buckets, want 1", len(sessions))
+	}
+	if console := sessions[0].Results["t1"].Console; len(console) != 2 || console[1].Message != "two" {
+		t.Errorf("reloaded console = %+v, want both parts", console)
+	}
+}